@@ -9,6 +9,7 @@ import (
 */
 type BooleanSchema struct {
 	Schema
+	Const *bool `json:"const"`
 }
 
 func NewBooleanSchema() *BooleanSchema {
@@ -37,5 +38,5 @@ func ParseBooleanSchema(contents []byte, context *SchemaParseContext) (*BooleanS
 }
 
 func (this *BooleanSchema) HasConstraints() bool {
-	return false
+	return this.Const != nil || this.Not != nil
 }
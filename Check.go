@@ -0,0 +1,193 @@
+package presilo
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+/*
+	A generated file whose contents on disk differ from what would be generated now.
+*/
+type StaleFile struct {
+
+	// the file's path relative to the target path, separated by "/".
+	Path string
+
+	// a unified diff from the file on disk to what would be generated.
+	Diff string
+}
+
+/*
+	The result of comparing generated code to what's already on disk; see CheckGeneratedCode.
+	Every path is relative to the target path, separated by "/", and sorted.
+*/
+type CheckResult struct {
+
+	// files which exist, but would be generated differently.
+	Stale []*StaleFile
+
+	// files which would be generated, but don't exist.
+	Missing []string
+
+	// files which look generated (they have protected regions), but would no longer be generated.
+	Orphaned []string
+}
+
+/*
+	Returns true if every generated file is on disk exactly as it would be generated, and nothing is left over.
+*/
+func (this *CheckResult) IsUpToDate() bool {
+	return len(this.Stale) == 0 && len(this.Missing) == 0 && len(this.Orphaned) == 0
+}
+
+/*
+	Returns a report of every out-of-date file, with a diff for each stale one.
+*/
+func (this *CheckResult) String() string {
+
+	var ret bytes.Buffer
+
+	for _, path := range this.Missing {
+		ret.WriteString(fmt.Sprintf("missing: %s\n", path))
+	}
+
+	for _, path := range this.Orphaned {
+		ret.WriteString(fmt.Sprintf("orphaned: %s\n", path))
+	}
+
+	for _, stale := range this.Stale {
+		ret.WriteString(fmt.Sprintf("stale: %s\n", stale.Path))
+		ret.WriteString(stale.Diff)
+	}
+
+	return ret.String()
+}
+
+/*
+	Generates code in memory exactly as WriteGeneratedCode would (including the protected regions of existing files),
+	and compares it to the files already in [targetPath]. Nothing on disk is modified.
+	Returns an error only if code couldn't be generated, or existing files couldn't be read; out-of-date files are described by the result.
+*/
+func CheckGeneratedCode(context *SchemaParseContext, module string, targetPath string, language string, tabstyle string, unsafeModule bool, splitFiles bool, options GeneratorOptions) (*CheckResult, error) {
+
+	var ret *CheckResult
+	var files map[string]string
+	var generator Generator
+	var existing []byte
+	var expected string
+	var err error
+
+	files, err = GenerateCode(context, module, language, tabstyle, unsafeModule, splitFiles, options)
+	if err != nil {
+		return nil, err
+	}
+
+	generator, _ = GetGenerator(language)
+	ret = new(CheckResult)
+
+	for _, path := range getSortedFileNames(files) {
+
+		existing, err = ioutil.ReadFile(filepath.Join(targetPath, filepath.FromSlash(path)))
+
+		if os.IsNotExist(err) {
+			ret.Missing = append(ret.Missing, path)
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// if the regions can't be kept, regenerating would fail, which makes the file just as out of date.
+		expected, err = MergeProtectedRegions(string(existing), files[path])
+		if err != nil {
+			expected = files[path]
+		}
+
+		if string(existing) != expected {
+			ret.Stale = append(ret.Stale, &StaleFile{
+				Path: path,
+				Diff: unifiedDiff("a/"+path, "b/"+path, string(existing), expected),
+			})
+		}
+	}
+
+	ret.Orphaned, err = findOrphanedFiles(targetPath, generator.GetFileExtension(), files)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+/*
+	Returns the relative paths of every file under [targetPath] with the given [extension] which contains a protected region,
+	but isn't one of the given generated [files].
+*/
+func findOrphanedFiles(targetPath string, extension string, files map[string]string) ([]string, error) {
+
+	var ret []string
+	var err error
+
+	err = filepath.Walk(targetPath, func(path string, info os.FileInfo, err error) error {
+
+		var relativePath string
+		var contents []byte
+		var found bool
+
+		if err != nil {
+
+			// a target path which doesn't exist yet has nothing in it.
+			if path == targetPath && os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if info.IsDir() || filepath.Ext(path) != "."+extension {
+			return nil
+		}
+
+		relativePath, err = filepath.Rel(targetPath, path)
+		if err != nil {
+			return err
+		}
+
+		relativePath = filepath.ToSlash(relativePath)
+
+		_, found = files[relativePath]
+		if found {
+			return nil
+		}
+
+		contents, err = ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if strings.Contains(string(contents), PROTECTED_REGION_BEGIN) {
+			ret = append(ret, relativePath)
+		}
+		return nil
+	})
+
+	sort.Strings(ret)
+	return ret, err
+}
+
+func getSortedFileNames(files map[string]string) []string {
+
+	var ret []string
+
+	for path, _ := range files {
+		ret = append(ret, path)
+	}
+
+	sort.Strings(ret)
+	return ret
+}
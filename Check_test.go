@@ -0,0 +1,185 @@
+package presilo
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckUpToDate(test *testing.T) {
+
+	var schemaContext *SchemaParseContext
+	var targetPath string
+	var result *CheckResult
+
+	schemaContext, targetPath = writeTestCheckedCode(test)
+
+	// custom code in a protected region is kept when regenerating, so it doesn't make a file stale.
+	editTestFile(test, filepath.Join(targetPath, "Owner.go"), "presilo:end", "// custom\n// presilo:end")
+
+	result = checkTestCode(test, schemaContext, targetPath)
+	if !result.IsUpToDate() {
+		test.Errorf("Freshly written code should be up to date, but got:\n%s", result)
+	}
+}
+
+func TestCheckStale(test *testing.T) {
+
+	var schemaContext *SchemaParseContext
+	var targetPath string
+	var result *CheckResult
+
+	schemaContext, targetPath = writeTestCheckedCode(test)
+	editTestFile(test, filepath.Join(targetPath, "Owner.go"), "type Owner struct {", "type Owner struct {\n\tEdited bool")
+
+	result = checkTestCode(test, schemaContext, targetPath)
+
+	if len(result.Stale) != 1 || result.Stale[0].Path != "Owner.go" {
+		test.Fatalf("Expected only Owner.go to be stale, but got:\n%s", result)
+	}
+
+	if !strings.Contains(result.Stale[0].Diff, "-\tEdited bool") {
+		test.Errorf("Expected the diff to remove the edit, but got:\n%s", result.Stale[0].Diff)
+	}
+
+	if len(result.Missing) > 0 || len(result.Orphaned) > 0 || result.IsUpToDate() {
+		test.Errorf("Expected a stale file, and nothing else, but got:\n%s", result)
+	}
+}
+
+func TestCheckMissing(test *testing.T) {
+
+	var schemaContext *SchemaParseContext
+	var targetPath string
+	var result *CheckResult
+	var err error
+
+	schemaContext, targetPath = writeTestCheckedCode(test)
+
+	err = os.Remove(filepath.Join(targetPath, "Address.go"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	result = checkTestCode(test, schemaContext, targetPath)
+
+	if len(result.Missing) != 1 || result.Missing[0] != "Address.go" {
+		test.Errorf("Expected only Address.go to be missing, but got:\n%s", result)
+	}
+
+	if len(result.Stale) > 0 || len(result.Orphaned) > 0 || result.IsUpToDate() {
+		test.Errorf("Expected a missing file, and nothing else, but got:\n%s", result)
+	}
+
+	// nothing on disk is modified by checking.
+	_, err = os.Stat(filepath.Join(targetPath, "Address.go"))
+	if !os.IsNotExist(err) {
+		test.Errorf("Checking should never write a missing file")
+	}
+}
+
+func TestCheckOrphaned(test *testing.T) {
+
+	var schemaContext *SchemaParseContext
+	var targetPath string
+	var result *CheckResult
+	var generated string
+
+	schemaContext, targetPath = writeTestCheckedCode(test)
+	generated = readTestFile(test, filepath.Join(targetPath, "Owner.go"))
+
+	// only files of the language's extension which have protected regions look generated.
+	writeTestFile(test, filepath.Join(targetPath, "nested", "Pet.go"), strings.Replace(generated, "Owner", "Pet", -1))
+	writeTestFile(test, filepath.Join(targetPath, "Handwritten.go"), "package conformance\n")
+	writeTestFile(test, filepath.Join(targetPath, "Pet.txt"), generated)
+
+	result = checkTestCode(test, schemaContext, targetPath)
+
+	if len(result.Orphaned) != 1 || result.Orphaned[0] != "nested/Pet.go" {
+		test.Errorf("Expected only nested/Pet.go to be orphaned, but got:\n%s", result)
+	}
+
+	if len(result.Stale) > 0 || len(result.Missing) > 0 || result.IsUpToDate() {
+		test.Errorf("Expected an orphaned file, and nothing else, but got:\n%s", result)
+	}
+}
+
+func TestCheckEmptyTarget(test *testing.T) {
+
+	var schemaContext *SchemaParseContext
+	var result *CheckResult
+
+	schemaContext, _ = writeTestCheckedCode(test)
+
+	// a target path which doesn't exist yet is missing every file.
+	result = checkTestCode(test, schemaContext, filepath.Join(test.TempDir(), "missing"))
+
+	if len(result.Missing) != 3 || result.Missing[0] != "Address.go" || result.Missing[2] != "Owner.go" {
+		test.Errorf("Expected every file to be missing, in order, but got:\n%s", result)
+	}
+}
+
+/*
+	Parses the "objects" conformance schema, and writes its Go code (one file per type) to a new temporary directory.
+*/
+func writeTestCheckedCode(test *testing.T) (*SchemaParseContext, string) {
+
+	var schemaContext *SchemaParseContext
+	var targetPath string
+	var err error
+
+	schemaContext, err = parseConformanceSchema(filepath.Join(conformanceDirectory, "schemas", "objects.json"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	targetPath = test.TempDir()
+
+	err = WriteGeneratedCode(context.Background(), schemaContext, "conformance", targetPath, "go", "\t", false, true, GeneratorOptions{})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return schemaContext, targetPath
+}
+
+func checkTestCode(test *testing.T, schemaContext *SchemaParseContext, targetPath string) *CheckResult {
+
+	var result *CheckResult
+	var err error
+
+	result, err = CheckGeneratedCode(schemaContext, "conformance", targetPath, "go", "\t", false, true, GeneratorOptions{})
+	if err != nil {
+		test.Fatal(err)
+	}
+	return result
+}
+
+func editTestFile(test *testing.T, path string, old string, new string) {
+
+	var contents string
+
+	contents = readTestFile(test, path)
+	if !strings.Contains(contents, old) {
+		test.Fatalf("Expected '%s' to contain '%s'", path, old)
+	}
+
+	writeTestFile(test, path, strings.Replace(contents, old, new, 1))
+}
+
+func writeTestFile(test *testing.T, path string, contents string) {
+
+	var err error
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err == nil {
+		err = ioutil.WriteFile(path, []byte(contents), 0644)
+	}
+
+	if err != nil {
+		test.Fatal(err)
+	}
+}
@@ -0,0 +1,437 @@
+package presilo
+
+import (
+	"strings"
+)
+
+/*
+	The code model is a language-neutral description of what generated code for an object schema must do;
+	its fields, constructor parameters, and the validation rules of each field.
+	Constraint semantics (such as which comparison violates an exclusive minimum) are decided here, once,
+	so that every generator renders the same rules instead of each interpreting the schema on its own.
+*/
+
+/*
+	The kind of check a ModelRule describes.
+*/
+type ModelRuleKind int
+
+const (
+	// the subject must compare to Value as described by Requirement.
+	MODELRULE_RANGE ModelRuleKind = iota
+
+	// the value must match the regex in Value.
+	MODELRULE_PATTERN
+
+	// the value must be one of the values in Value.
+	MODELRULE_ENUM
+
+	// the value must be a multiple of Value.
+	MODELRULE_MULTIPLE
+
+	// the value must not match the subschema (a TypeSchema) in Value, whose own rules are checked against it.
+	MODELRULE_NOT
+
+	// no two items of an array may be equal.
+	MODELRULE_UNIQUE
+)
+
+/*
+	What part of a value a ModelRule checks.
+*/
+type ModelSubject int
+
+const (
+	// the value itself.
+	MODELSUBJECT_VALUE ModelSubject = iota
+
+	// the number of characters in a string.
+	MODELSUBJECT_LENGTH
+
+	// the number of bytes in a string (or in binary content).
+	MODELSUBJECT_BYTE_LENGTH
+
+	// the number of items in an array.
+	MODELSUBJECT_ITEMS
+)
+
+/*
+	One validation rule of a field.
+*/
+type ModelRule struct {
+	Kind    ModelRuleKind
+	Subject ModelSubject
+
+	// the bound, pattern (string), enum values ([]interface{}), multiple, or not subschema which the rule checks against. Unique rules hold true.
+	Value interface{}
+
+	// the json-schema keyword the rule comes from, such as "minLength" or "const".
+	Keyword string
+
+	// for ranges, the comparison of the subject to Value which must hold ("<", "<=", ">", ">="),
+	// and the comparison which means the rule is violated (the opposite of Requirement).
+	Requirement string
+	Violation   string
+
+	// a language-neutral description of a violation, such as "is under the allowable minimum".
+	Message string
+}
+
+/*
+	One field of a ModelType.
+*/
+type ModelField struct {
+
+	// the property name, exactly as it appears in the schema.
+	Name   string
+	Schema TypeSchema
+
+	// true if the property is listed in "required" (whether or not it's nullable).
+	Required bool
+
+	// false for readOnly and const fields, which consumers never set.
+	Settable bool
+
+	// for const fields, the only value they can have.
+	Const   interface{}
+	IsConst bool
+
+	Rules []*ModelRule
+
+	// for arrays, the items of the field. Nil otherwise.
+	Items *ModelField
+}
+
+/*
+	The code model of one object schema.
+*/
+type ModelType struct {
+	Name        string
+	Description string
+
+	// every field, in declaration order (see ObjectSchema.GetOrderedPropertyNames).
+	Fields []*ModelField
+
+	// the fields a constructor accepts, in the order of "required".
+	// readOnly fields are owned by whoever produces the data, and const fields can only have one value, so neither are ever included.
+	ConstructorFields []*ModelField
+
+	Schema *ObjectSchema
+}
+
+/*
+	Creates the code model for the given [schema].
+*/
+func NewModelType(schema *ObjectSchema) *ModelType {
+
+	var ret *ModelType
+	var fields map[string]*ModelField
+	var field *ModelField
+
+	ret = new(ModelType)
+	ret.Name = schema.GetTitle()
+	ret.Description = schema.GetDescription()
+	ret.Schema = schema
+
+	fields = make(map[string]*ModelField)
+
+	for _, propertyName := range schema.GetOrderedPropertyNames() {
+
+		field = newModelField(propertyName, schema.Properties[propertyName])
+		field.Required = arrayContainsString(schema.RequiredProperties, propertyName)
+
+		fields[propertyName] = field
+		ret.Fields = append(ret.Fields, field)
+	}
+
+	for _, propertyName := range schema.RequiredProperties {
+
+		field = fields[propertyName]
+		if field != nil && field.Settable {
+			ret.ConstructorFields = append(ret.ConstructorFields, field)
+		}
+	}
+
+	return ret
+}
+
+func newModelField(name string, schema TypeSchema) *ModelField {
+
+	var ret *ModelField
+
+	ret = new(ModelField)
+	ret.Name = name
+	ret.Schema = schema
+	ret.Const, ret.IsConst = getConstValue(schema)
+	ret.Settable = !schema.IsReadOnly() && !ret.IsConst
+	ret.Rules = GetModelRules(schema)
+
+	if schema.GetSchemaType() == SCHEMATYPE_ARRAY {
+		ret.Items = newModelField(name, schema.(*ArraySchema).Items)
+	}
+
+	return ret
+}
+
+/*
+	Returns the field of this type with the given property [name], or nil if there isn't one.
+*/
+func (this *ModelType) GetField(name string) *ModelField {
+
+	for _, field := range this.Fields {
+
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+/*
+	Returns true if the given [field] is one of this type's constructor parameters.
+*/
+func (this *ModelType) IsConstructorField(field *ModelField) bool {
+
+	for _, constructorField := range this.ConstructorFields {
+
+		if constructorField == field {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+	Returns the fields of this type which are writeOnly, and so must be left out of any serializer.
+*/
+func (this *ModelType) GetWriteOnlyFields() []*ModelField {
+
+	var ret []*ModelField
+
+	for _, field := range this.Fields {
+
+		if field.Schema.IsWriteOnly() {
+			ret = append(ret, field)
+		}
+	}
+	return ret
+}
+
+/*
+	Returns the fields of this type which have a "const" value.
+*/
+func (this *ModelType) GetConstFields() []*ModelField {
+
+	var ret []*ModelField
+
+	for _, field := range this.Fields {
+
+		if field.IsConst {
+			ret = append(ret, field)
+		}
+	}
+	return ret
+}
+
+/*
+	Returns the validation rules of the given [schema] (not including those of array items), in the order generated code should check them.
+	A "not" subschema is one rule, which is checked first.
+*/
+func GetModelRules(schema TypeSchema) []*ModelRule {
+
+	var ret []*ModelRule
+
+	if schema.GetNot() != nil {
+		ret = append(ret, &ModelRule{Kind: MODELRULE_NOT, Value: schema.GetNot(), Keyword: "not", Message: "matched a schema which it must not match"})
+	}
+
+	switch schema.GetSchemaType() {
+
+	case SCHEMATYPE_STRING:
+		ret = append(ret, getStringModelRules(schema.(*StringSchema))...)
+
+	case SCHEMATYPE_NUMBER:
+		fallthrough
+	case SCHEMATYPE_INTEGER:
+		ret = append(ret, getNumericModelRules(schema.(NumericSchemaType))...)
+
+	case SCHEMATYPE_BOOLEAN:
+
+		// booleans have no enum, so a const is its only value.
+		if schema.(*BooleanSchema).Const != nil {
+			ret = append(ret, &ModelRule{Kind: MODELRULE_ENUM, Value: []interface{}{*schema.(*BooleanSchema).Const}, Keyword: "const", Message: "is not one of the allowable values"})
+		}
+
+	case SCHEMATYPE_ARRAY:
+
+		if schema.(*ArraySchema).MinItems != nil {
+			ret = append(ret, newMinimumRule(MODELSUBJECT_ITEMS, *schema.(*ArraySchema).MinItems, false, "does not have enough items"))
+		}
+
+		if schema.(*ArraySchema).MaxItems != nil {
+			ret = append(ret, newMaximumRule(MODELSUBJECT_ITEMS, *schema.(*ArraySchema).MaxItems, false, "has too many items"))
+		}
+
+		if schema.(*ArraySchema).UniqueItems != nil && *schema.(*ArraySchema).UniqueItems {
+			ret = append(ret, &ModelRule{Kind: MODELRULE_UNIQUE, Subject: MODELSUBJECT_ITEMS, Value: true, Keyword: "uniqueItems", Message: "has duplicate items"})
+		}
+	}
+
+	return ret
+}
+
+/*
+	Returns the rule of the given [kind] which checks the value of the given [schema] itself, or nil if it has none.
+	Used for enum, pattern, multiple, and not rules, which a schema never has more than one of.
+*/
+func getModelRule(schema TypeSchema, kind ModelRuleKind) *ModelRule {
+
+	var rules []*ModelRule
+
+	rules = filterModelRules(GetModelRules(schema), kind, MODELSUBJECT_VALUE)
+	if len(rules) == 0 {
+		return nil
+	}
+	return rules[0]
+}
+
+/*
+	Returns only the rules of the given [kind] and [subject] from the given [rules].
+*/
+func filterModelRules(rules []*ModelRule, kind ModelRuleKind, subject ModelSubject) []*ModelRule {
+
+	var ret []*ModelRule
+
+	for _, rule := range rules {
+
+		if rule.Kind == kind && rule.Subject == subject {
+			ret = append(ret, rule)
+		}
+	}
+	return ret
+}
+
+func getStringModelRules(schema *StringSchema) []*ModelRule {
+
+	var ret []*ModelRule
+
+	if schema.Enum != nil {
+		ret = append(ret, &ModelRule{Kind: MODELRULE_ENUM, Value: schema.GetEnum(), Keyword: getEnumKeyword(schema), Message: "is not one of the allowable values"})
+	}
+
+	if schema.MinLength != nil {
+		ret = append(ret, newMinimumRule(MODELSUBJECT_LENGTH, *schema.MinLength, false, "was shorter than allowable minimum"))
+	}
+
+	if schema.MaxLength != nil {
+		ret = append(ret, newMaximumRule(MODELSUBJECT_LENGTH, *schema.MaxLength, false, "was longer than allowable maximum"))
+	}
+
+	if schema.MinByteLength != nil {
+		ret = append(ret, newMinimumRule(MODELSUBJECT_BYTE_LENGTH, *schema.MinByteLength, false, "had fewer bytes than allowable minimum"))
+	}
+
+	if schema.MaxByteLength != nil {
+		ret = append(ret, newMaximumRule(MODELSUBJECT_BYTE_LENGTH, *schema.MaxByteLength, false, "had more bytes than allowable maximum"))
+	}
+
+	if schema.Pattern != nil {
+		ret = append(ret, &ModelRule{Kind: MODELRULE_PATTERN, Value: *schema.Pattern, Keyword: "pattern", Message: "did not match the required pattern"})
+	}
+
+	return ret
+}
+
+func getNumericModelRules(schema NumericSchemaType) []*ModelRule {
+
+	var ret []*ModelRule
+
+	if schema.HasEnum() {
+		ret = append(ret, &ModelRule{Kind: MODELRULE_ENUM, Value: schema.GetEnum(), Keyword: getEnumKeyword(schema.(TypeSchema)), Message: "is not one of the allowable values"})
+	}
+
+	if schema.HasMinimum() {
+		ret = append(ret, newMinimumRule(MODELSUBJECT_VALUE, schema.GetMinimum(), schema.IsExclusiveMinimum(), "is under the allowable minimum"))
+	}
+
+	if schema.HasMaximum() {
+		ret = append(ret, newMaximumRule(MODELSUBJECT_VALUE, schema.GetMaximum(), schema.IsExclusiveMaximum(), "is over the allowable maximum"))
+	}
+
+	if schema.HasMultiple() {
+		ret = append(ret, &ModelRule{Kind: MODELRULE_MULTIPLE, Value: schema.GetMultiple(), Keyword: "multipleOf", Message: "is not a multiple of the allowable value"})
+	}
+
+	return ret
+}
+
+/*
+	An inclusive minimum is violated by anything less than it, an exclusive one also by the bound itself.
+*/
+func newMinimumRule(subject ModelSubject, value interface{}, exclusive bool, message string) *ModelRule {
+
+	var ret *ModelRule
+
+	ret = &ModelRule{Kind: MODELRULE_RANGE, Subject: subject, Value: value, Message: message}
+	ret.Keyword = getRangeKeyword(subject, "min", exclusive)
+
+	if exclusive {
+		ret.Requirement = ">"
+		ret.Violation = "<="
+	} else {
+		ret.Requirement = ">="
+		ret.Violation = "<"
+	}
+	return ret
+}
+
+/*
+	An inclusive maximum is violated by anything greater than it, an exclusive one also by the bound itself.
+*/
+func newMaximumRule(subject ModelSubject, value interface{}, exclusive bool, message string) *ModelRule {
+
+	var ret *ModelRule
+
+	ret = &ModelRule{Kind: MODELRULE_RANGE, Subject: subject, Value: value, Message: message}
+	ret.Keyword = getRangeKeyword(subject, "max", exclusive)
+
+	if exclusive {
+		ret.Requirement = "<"
+		ret.Violation = ">="
+	} else {
+		ret.Requirement = "<="
+		ret.Violation = ">"
+	}
+	return ret
+}
+
+/*
+	Returns the keyword of a range rule on the given [subject]; such as "minLength", or "exclusiveMaximum" for an exclusive "max" [bound] of a value.
+*/
+func getRangeKeyword(subject ModelSubject, bound string, exclusive bool) string {
+
+	switch subject {
+	case MODELSUBJECT_LENGTH:
+		return bound + "Length"
+	case MODELSUBJECT_BYTE_LENGTH:
+		return bound + "ByteLength"
+	case MODELSUBJECT_ITEMS:
+		return bound + "Items"
+	}
+
+	if exclusive {
+		return "exclusive" + strings.ToUpper(bound[:1]) + bound[1:] + "imum"
+	}
+	return bound + "imum"
+}
+
+/*
+	An enum with one value is how a "const" is checked, and should be named as one.
+*/
+func getEnumKeyword(schema TypeSchema) string {
+
+	if _, isConst := getConstValue(schema); isConst {
+		return "const"
+	}
+	return "enum"
+}
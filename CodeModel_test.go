@@ -0,0 +1,56 @@
+package presilo
+
+import (
+	"testing"
+)
+
+func TestModelTypeFields(test *testing.T) {
+
+	var schema TypeSchema
+	var model *ModelType
+	var names []string
+	var err error
+
+	schema, err = ParseSchema([]byte(`{
+		"title": "Thing",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer", "readOnly": true},
+			"kind": {"type": "string", "const": "thing"},
+			"name": {"type": "string", "minLength": 1},
+			"tags": {"type": "array", "items": {"type": "string", "not": {"maxLength": 0}}},
+			"count": {"type": "integer"}
+		},
+		"required": ["tags", "id", "kind", "name"]
+	}`), "thing", NewSchemaParseContext())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	model = NewModelType(schema.(*ObjectSchema))
+
+	// readOnly and const fields are never constructor parameters.
+	for _, field := range model.ConstructorFields {
+		names = append(names, field.Name)
+	}
+
+	if len(names) != 2 || names[0] != "tags" || names[1] != "name" {
+		test.Errorf("Expected constructor fields [tags name], in the order of 'required', but got %v", names)
+	}
+
+	if model.GetField("id").Settable || model.GetField("kind").Settable || !model.GetField("name").Settable {
+		test.Errorf("Expected only fields which are neither readOnly nor const to be settable")
+	}
+
+	if !model.GetField("kind").IsConst || model.GetField("kind").Const != "thing" {
+		test.Errorf("Expected the const field to have its value, but got %v", model.GetField("kind").Const)
+	}
+
+	if model.GetField("count").Required || !model.GetField("name").Required {
+		test.Errorf("Expected fields to be required only if they're listed in 'required'")
+	}
+
+	if model.GetField("tags").Items == nil || getModelRule(model.GetField("tags").Items.Schema, MODELRULE_NOT) == nil {
+		test.Errorf("Expected the array's items to be modelled, with their 'not' rule")
+	}
+}
@@ -0,0 +1,131 @@
+package presilo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+/*
+  A schema which applies to an object as a whole, rather than describing one of its fields.
+  Used for "if", "then", "else", and "dependentSchemas".
+
+  Conditional schemas never have a type of their own, and never generate a type declaration.
+  They may only constrain properties which are already defined by the object which owns them,
+  and each property subschema is parsed using the type of the owner's property of the same name.
+*/
+type ConditionalSchema struct {
+	RequiredProperties []string `json:"required"`
+	Properties         map[string]TypeSchema
+	RawProperties      map[string]*json.RawMessage `json:"properties"`
+
+	// property names, in the same order as the owner's properties.
+	propertyOrder []string
+}
+
+/*
+  Parses the properties of this conditional schema, using the given [owner] to determine the type of each one.
+  Only scalar (string, number, integer, boolean) properties can be constrained by a condition.
+*/
+func (this *ConditionalSchema) parseProperties(owner *ObjectSchema) error {
+
+	var ownerProperty, sub TypeSchema
+	var propertyContents *json.RawMessage
+	var found bool
+	var err error
+
+	this.Properties = make(map[string]TypeSchema)
+
+	for _, propertyName := range this.RequiredProperties {
+
+		_, found = owner.RawProperties[propertyName]
+		if !found {
+			errorMsg := fmt.Sprintf("Property '%s' was listed as conditionally required, but was not defined\n", propertyName)
+			return errors.New(errorMsg)
+		}
+	}
+
+	for _, propertyName := range getSortedRawKeys(this.RawProperties) {
+
+		propertyContents = this.RawProperties[propertyName]
+
+		ownerProperty, found = owner.Properties[propertyName]
+		if !found {
+			errorMsg := fmt.Sprintf("Property '%s' was used in a condition, but was not defined\n", propertyName)
+			return errors.New(errorMsg)
+		}
+
+		if len(getScalarSchemaTypeName(ownerProperty.GetSchemaType())) == 0 {
+			errorMsg := fmt.Sprintf("Property '%s' cannot be used in a condition, only strings, numbers, integers, and booleans are supported\n", propertyName)
+			return errors.New(errorMsg)
+		}
+
+		// conditions don't usually repeat the type, so borrow it from the owner.
+		sub, err = parseScalarSubschema(propertyContents, ownerProperty, propertyName)
+		if err != nil {
+			return err
+		}
+
+		if sub.GetSchemaType() != ownerProperty.GetSchemaType() {
+			errorMsg := fmt.Sprintf("Property '%s' has type '%s', but a condition gave it type '%s'\n", propertyName, getScalarSchemaTypeName(ownerProperty.GetSchemaType()), getScalarSchemaTypeName(sub.GetSchemaType()))
+			return errors.New(errorMsg)
+		}
+
+		this.Properties[propertyName] = sub
+	}
+
+	this.order(owner)
+	return nil
+}
+
+/*
+	Orders the properties of this condition the same way as the properties of the given [owner].
+*/
+func (this *ConditionalSchema) order(owner *ObjectSchema) {
+
+	this.propertyOrder = nil
+
+	for _, propertyName := range owner.GetOrderedPropertyNames() {
+
+		if _, found := this.Properties[propertyName]; found {
+			this.propertyOrder = append(this.propertyOrder, propertyName)
+		}
+	}
+}
+
+/*
+	Returns an ordered array of the property names constrained by this condition,
+	in the same order as the properties of the object which owns it.
+*/
+func (this *ConditionalSchema) GetOrderedPropertyNames() []string {
+
+	var ret []string
+
+	for _, key := range this.propertyOrder {
+
+		if _, found := this.Properties[key]; found {
+			ret = append(ret, key)
+		}
+	}
+	return ret
+}
+
+/*
+	Returns the json-schema type name of the given scalar [schemaType],
+	or an empty string if the type is not a scalar.
+*/
+func getScalarSchemaTypeName(schemaType SchemaType) string {
+
+	switch schemaType {
+	case SCHEMATYPE_STRING:
+		return "string"
+	case SCHEMATYPE_INTEGER:
+		return "integer"
+	case SCHEMATYPE_NUMBER:
+		return "number"
+	case SCHEMATYPE_BOOLEAN:
+		return "boolean"
+	}
+
+	return ""
+}
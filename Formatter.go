@@ -0,0 +1,109 @@
+package presilo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+/*
+	Reformats code which was generated for one language, returning the formatted code.
+	An error means the code couldn't be formatted, and fails generation.
+*/
+type Formatter func(code string) (string, error)
+
+var formatters map[string]Formatter
+var formatterLock sync.RWMutex
+
+func init() {
+
+	formatters = map[string]Formatter{
+		"go": FormatGo,
+	}
+}
+
+/*
+	Formats the given Go [code] the same way gofmt would.
+*/
+func FormatGo(code string) (string, error) {
+
+	var formatted []byte
+	var err error
+
+	formatted, err = format.Source([]byte(code))
+	if err != nil {
+		return "", err
+	}
+
+	return string(formatted), nil
+}
+
+/*
+	Returns a Formatter which runs the given external [command] (such as "rustfmt" or "black -q -"),
+	giving it generated code on stdin and using whatever it writes to stdout.
+	The command fails generation if it exits with an error.
+*/
+func NewCommandFormatter(command string, args ...string) Formatter {
+
+	return func(code string) (string, error) {
+
+		var cmd *exec.Cmd
+		var stdout, stderr bytes.Buffer
+		var err error
+
+		cmd = exec.Command(command, args...)
+		cmd.Stdin = strings.NewReader(code)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		err = cmd.Run()
+		if err != nil {
+			errorMsg := fmt.Sprintf("Formatter '%s' failed: %s %s", command, err.Error(), strings.TrimSpace(stderr.String()))
+			return "", errors.New(errorMsg)
+		}
+
+		return stdout.String(), nil
+	}
+}
+
+/*
+	Makes every file generated for the given [language] pass through the given [formatter] before it's written,
+	replacing any formatter already registered for that language (including the built-in one for Go).
+	A nil [formatter] turns formatting off for that language.
+*/
+func RegisterFormatter(language string, formatter Formatter) error {
+
+	if len(language) == 0 {
+		return errors.New("Formatters must be registered with a language name")
+	}
+
+	formatterLock.Lock()
+	defer formatterLock.Unlock()
+
+	if formatter == nil {
+		delete(formatters, language)
+		return nil
+	}
+
+	formatters[language] = formatter
+	return nil
+}
+
+/*
+	Returns the formatter registered for the given [language], and whether or not one was found.
+*/
+func GetFormatter(language string) (Formatter, bool) {
+
+	var ret Formatter
+	var found bool
+
+	formatterLock.RLock()
+	defer formatterLock.RUnlock()
+
+	ret, found = formatters[language]
+	return ret, found
+}
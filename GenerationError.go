@@ -0,0 +1,81 @@
+package presilo
+
+import (
+	"bytes"
+	"fmt"
+)
+
+/*
+	An error encountered while generating code for one object schema.
+*/
+type GenerationError struct {
+	Schema string
+	Err    error
+}
+
+func (this *GenerationError) Error() string {
+	return fmt.Sprintf("%s: %s", this.Schema, this.Err.Error())
+}
+
+/*
+	Every error encountered while generating code, one per object schema which failed.
+*/
+type GenerationErrors []*GenerationError
+
+func (this GenerationErrors) Error() string {
+
+	var errs []error
+
+	for _, err := range this {
+		errs = append(errs, err)
+	}
+	return joinErrors(errs)
+}
+
+/*
+	An error encountered while writing one generated file.
+*/
+type OutputFileError struct {
+
+	// the file's path relative to the target path, separated by "/".
+	Path string
+	Err  error
+}
+
+func (this *OutputFileError) Error() string {
+	return fmt.Sprintf("%s: %s", this.Path, this.Err.Error())
+}
+
+/*
+	Every error encountered while writing generated files, one per file which failed.
+*/
+type OutputFileErrors []*OutputFileError
+
+func (this OutputFileErrors) Error() string {
+
+	var errs []error
+
+	for _, err := range this {
+		errs = append(errs, err)
+	}
+	return joinErrors(errs)
+}
+
+/*
+	Returns the message of each of the given [errs], one per line.
+	Used by every error type which collects one error per schema, file, or target.
+*/
+func joinErrors(errs []error) string {
+
+	var ret bytes.Buffer
+
+	for i, err := range errs {
+
+		if i > 0 {
+			ret.WriteString("\n")
+		}
+		ret.WriteString(err.Error())
+	}
+
+	return ret.String()
+}
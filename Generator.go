@@ -0,0 +1,254 @@
+package presilo
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/*
+	Determines whether generated code is written to one file per module, or one file per type.
+*/
+type OutputStrategy int
+
+const (
+	// honor whatever the caller asked for.
+	OUTPUTSTRATEGY_EITHER OutputStrategy = iota
+
+	// always write every type to one file, named after the module.
+	OUTPUTSTRATEGY_SINGLE
+
+	// always write each type to its own file.
+	OUTPUTSTRATEGY_SPLIT
+)
+
+/*
+	A code generator for one target language.
+	Every generator is used by the same output pipeline, see RegisterGenerator and WriteGeneratedCode.
+*/
+type Generator interface {
+
+	// Generates code for one object schema, in the given module.
+	Generate(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string
+
+	// Returns true if the given module name is valid for this language.
+	ValidateModule(module string) bool
+
+	// Returns the extension (without a leading dot) of generated files.
+	GetFileExtension() string
+
+	// Returns the path (without extension) of the file the given schema is written to, when writing one file per type.
+	// The path is relative to the target path, separated by "/", and may include directories (such as a Java package).
+	GetFileName(schema *ObjectSchema, module string) string
+
+	// Returns the code which loads each of the given [dependencies] of [schema] from its own file, when writing one file per type.
+	// It's written before the schema's own code. Empty if nothing needs loading, such as types in the same package.
+	GenerateImports(schema *ObjectSchema, dependencies []*ObjectSchema, module string, tabstyle string) string
+
+	// Returns the path (without extension, like GetFileName) and code of a file which loads every one of the given [schemas],
+	// when writing one file per type. Schemas are given with dependencies first. The path is empty if the language has no such file.
+	GenerateIndex(schemas []*ObjectSchema, module string, tabstyle string) (string, string)
+
+	// Returns the code of one file holding every type, given the [code] generated for each of them (dependencies first),
+	// when writing every type to one file. Anything each type's code begins with that a file may only have once,
+	// such as a package clause, should appear once.
+	CombineFiles(code []string, module string) (string, error)
+
+	GetOutputStrategy() OutputStrategy
+
+	// Returns what begins a line comment in this language (such as "//"), which is used to write the provenance header of each file.
+	// Files get no header if this is empty.
+	GetLineComment() string
+}
+
+/*
+	A Generator which can fail to generate code for a schema, such as a TemplateGenerator whose template fails to render.
+	The output pipeline calls TryGenerate in place of Generate, and reports any error it returns as a GenerationError.
+*/
+type FallibleGenerator interface {
+	Generator
+
+	// Generates code for one object schema, in the given module, or returns why it couldn't.
+	TryGenerate(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) (string, error)
+}
+
+/*
+	A Generator made of plain functions, which is how all the built-in generators are defined.
+	Only GenerateFunc is required. Without a ModuleValidator every module is valid,
+	without a FileNamer files are named after the title of their schema, and without a LineComment files have no provenance header.
+	Without an ImportGenerator or IndexGenerator, split files load nothing, and have no index.
+	Without a FileCombiner, a single file is the code of every type, one after the other.
+*/
+type BasicGenerator struct {
+	GenerateFunc    func(*ObjectSchema, string, string, GeneratorOptions) string
+	ModuleValidator func(string) bool
+	FileNamer       func(*ObjectSchema, string) string
+	ImportGenerator func(*ObjectSchema, []*ObjectSchema, string, string) string
+	IndexGenerator  func([]*ObjectSchema, string, string) (string, string)
+	FileCombiner    func([]string, string) (string, error)
+	Extension       string
+	LineComment     string
+	Strategy        OutputStrategy
+}
+
+func (this *BasicGenerator) Generate(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
+	return this.GenerateFunc(schema, module, tabstyle, options)
+}
+
+func (this *BasicGenerator) ValidateModule(module string) bool {
+
+	if this.ModuleValidator == nil {
+		return true
+	}
+	return this.ModuleValidator(module)
+}
+
+func (this *BasicGenerator) GetFileExtension() string {
+	return this.Extension
+}
+
+func (this *BasicGenerator) GetFileName(schema *ObjectSchema, module string) string {
+
+	if this.FileNamer == nil {
+		return schema.GetTitle()
+	}
+	return this.FileNamer(schema, module)
+}
+
+func (this *BasicGenerator) GenerateImports(schema *ObjectSchema, dependencies []*ObjectSchema, module string, tabstyle string) string {
+
+	if this.ImportGenerator == nil || len(dependencies) == 0 {
+		return ""
+	}
+	return this.ImportGenerator(schema, dependencies, module, tabstyle)
+}
+
+func (this *BasicGenerator) GenerateIndex(schemas []*ObjectSchema, module string, tabstyle string) (string, string) {
+
+	if this.IndexGenerator == nil {
+		return "", ""
+	}
+	return this.IndexGenerator(schemas, module, tabstyle)
+}
+
+func (this *BasicGenerator) CombineFiles(code []string, module string) (string, error) {
+
+	if this.FileCombiner == nil {
+		return strings.Join(code, ""), nil
+	}
+	return this.FileCombiner(code, module)
+}
+
+func (this *BasicGenerator) GetOutputStrategy() OutputStrategy {
+	return this.Strategy
+}
+
+func (this *BasicGenerator) GetLineComment() string {
+	return this.LineComment
+}
+
+/*
+	Combines the given [code] of several types into one file, for languages whose headers are lines of their own.
+	Every line (ignoring surrounding whitespace) at the start of each type's code which is blank or [isHeader]
+	is part of its header. The combined file has each distinct header line once, in the order they were first seen,
+	followed by the rest of each type's code.
+*/
+func combineHeaderLines(code []string, isHeader func(line string) bool) string {
+
+	var headers, bodies []string
+	var lines []string
+	var line string
+	var i int
+
+	for _, file := range code {
+
+		lines = strings.Split(file, "\n")
+
+		for i = 0; i < len(lines); i++ {
+
+			line = strings.TrimSpace(lines[i])
+			if len(line) > 0 && !isHeader(line) {
+				break
+			}
+
+			if len(line) > 0 && !arrayContainsString(headers, line) {
+				headers = append(headers, line)
+			}
+		}
+
+		bodies = append(bodies, strings.TrimRight(strings.Join(lines[i:], "\n"), "\n")+"\n")
+	}
+
+	return strings.Join(headers, "\n") + "\n\n" + strings.Join(bodies, "\n")
+}
+
+var generators map[string]Generator
+var generatorLock sync.RWMutex
+
+func init() {
+
+	generators = map[string]Generator{
+		"go":    &BasicGenerator{GenerateFunc: GenerateGo, ModuleValidator: ValidateGoModule, FileCombiner: CombineGoFiles, Extension: "go", LineComment: "//"},
+		"js":    &BasicGenerator{GenerateFunc: GenerateJS, ModuleValidator: ValidateJSModule, FileNamer: GetJSFileName, ImportGenerator: GenerateJSImports, IndexGenerator: GenerateJSIndex, Extension: "js", LineComment: "//"},
+		"java":  &BasicGenerator{GenerateFunc: GenerateJava, ModuleValidator: ValidateJavaModule, FileNamer: GetJavaFileName, FileCombiner: CombineJavaFiles, Extension: "java", LineComment: "//"},
+		"cs":    &BasicGenerator{GenerateFunc: GenerateCSharp, ModuleValidator: ValidateCSharpModule, FileNamer: GetCSharpFileName, FileCombiner: CombineCSharpFiles, Extension: "cs", LineComment: "//"},
+		"rb":    &BasicGenerator{GenerateFunc: GenerateRuby, ModuleValidator: ValidateRubyModule, FileNamer: GetRubyFileName, ImportGenerator: GenerateRubyImports, IndexGenerator: GenerateRubyIndex, Extension: "rb", LineComment: "#"},
+		"py":    &BasicGenerator{GenerateFunc: GeneratePython, ModuleValidator: ValidatePythonModule, FileNamer: GetPythonFileName, ImportGenerator: GeneratePythonImports, IndexGenerator: GeneratePythonIndex, Extension: "py", LineComment: "#"},
+		"mysql": &BasicGenerator{GenerateFunc: GenerateMySQL, ModuleValidator: ValidateMySQLModule, Extension: "sql", LineComment: "--"},
+	}
+}
+
+/*
+	Makes the given [generator] available under the given [language] name, replacing any generator already registered with that name.
+	Once registered, a generator can be used by WriteGeneratedCode just like the built-in ones.
+*/
+func RegisterGenerator(language string, generator Generator) error {
+
+	if len(language) == 0 {
+		return errors.New("Generators must be registered with a language name")
+	}
+
+	if generator == nil {
+		return errors.New("Cannot register a nil generator")
+	}
+
+	generatorLock.Lock()
+	defer generatorLock.Unlock()
+
+	generators[language] = generator
+	return nil
+}
+
+/*
+	Returns the generator registered for the given [language], and whether or not one was found.
+*/
+func GetGenerator(language string) (Generator, bool) {
+
+	var ret Generator
+	var found bool
+
+	generatorLock.RLock()
+	defer generatorLock.RUnlock()
+
+	ret, found = generators[language]
+	return ret, found
+}
+
+/*
+	Returns the (sorted) names of every registered generator.
+*/
+func GetGeneratorNames() []string {
+
+	var ret []string
+
+	generatorLock.RLock()
+	defer generatorLock.RUnlock()
+
+	for language, _ := range generators {
+		ret = append(ret, language)
+	}
+
+	sort.Strings(ret)
+	return ret
+}
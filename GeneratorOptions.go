@@ -0,0 +1,41 @@
+package presilo
+
+/*
+	Settings which change what generators produce, given to every Generate* function.
+	The zero value is the default behavior of every generator.
+*/
+type GeneratorOptions struct {
+
+	// The "-g" setting; generates a getter and setter for every field, instead of only those with constraints.
+	// Fields are made private wherever the language allows it, so that the accessors are the only way to reach them.
+	AllAccessors bool `json:"allAccessors"`
+}
+
+/*
+	Returns every field of the given [model] which has a getter and setter generated, in declaration order.
+	Only constrained fields have them, unless every field is asked for.
+*/
+func getAccessorFields(model *ModelType, options GeneratorOptions) []*ModelField {
+
+	var ret []*ModelField
+
+	for _, field := range model.Fields {
+
+		if options.AllAccessors || field.Schema.HasConstraints() {
+			ret = append(ret, field)
+		}
+	}
+	return ret
+}
+
+/*
+	Returns the visibility of fields in languages which have access modifiers (Java and C#).
+	Fields are protected (so subclasses can reach them) unless every field has accessors, in which case they're private.
+*/
+func getFieldVisibility(options GeneratorOptions) string {
+
+	if options.AllAccessors {
+		return "private"
+	}
+	return "protected"
+}
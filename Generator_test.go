@@ -0,0 +1,118 @@
+package presilo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegisterGeneratorErrors(test *testing.T) {
+
+	var found bool
+	var err error
+
+	err = RegisterGenerator("", &BasicGenerator{GenerateFunc: generateTestCode})
+	if err == nil {
+		test.Errorf("Expected a generator without a language name to fail to register")
+	}
+
+	err = RegisterGenerator("nothing", nil)
+	if err == nil {
+		test.Errorf("Expected a nil generator to fail to register")
+	}
+
+	_, found = GetGenerator("nothing")
+	if found {
+		test.Errorf("A generator which failed to register should never be found")
+	}
+}
+
+func TestRegisterGenerator(test *testing.T) {
+
+	var generator, found Generator
+	var names []string
+	var exists bool
+
+	generator = &BasicGenerator{GenerateFunc: generateTestCode, Extension: "txt"}
+	registerTestGenerator(test, "custom", generator)
+
+	found, exists = GetGenerator("custom")
+	if !exists || found != generator {
+		test.Errorf("Expected the registered generator to be found by its name")
+	}
+
+	names = GetGeneratorNames()
+	if strings.Join(names, ",") != "cs,custom,go,java,js,mysql,py,rb" {
+		test.Errorf("Expected every generator's name, sorted, but got %v", names)
+	}
+}
+
+func TestReplaceBuiltInGenerator(test *testing.T) {
+
+	var builtIn, generator Generator
+	var context *SchemaParseContext
+	var files map[string]string
+	var err error
+
+	builtIn, _ = GetGenerator("go")
+
+	context = NewSchemaParseContext()
+	_, err = ParseSchema([]byte(`{"title": "Widget", "type": "object", "properties": {"name": {"type": "string"}}}`), "widget", context)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	test.Run("replaced", func(test *testing.T) {
+
+		registerTestGenerator(test, "go", &BasicGenerator{GenerateFunc: generateTestCode, Extension: "txt"})
+
+		files, err = GenerateCode(context, "widgets", "go", "\t", false, true, GeneratorOptions{})
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		// the language's formatter still applies to whatever generator is registered for it.
+		if files["Widget.txt"] != "package widgets\n\ntype Widget struct{ Name string }\n" || len(files) != 1 {
+			test.Errorf("Expected formatted code from the replacement generator, but got %v", files)
+		}
+	})
+
+	// once the test is done, the built-in generator is back.
+	generator, _ = GetGenerator("go")
+	if generator != builtIn {
+		test.Errorf("Expected the built-in generator to be restored")
+	}
+}
+
+func generateTestCode(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
+	return "package " + module + "\n\ntype " + schema.GetTitle() + " struct{Name string}\n"
+}
+
+/*
+	Registers the given [generator] for the given [language] for the duration of the given test,
+	then puts back whatever was registered before (or nothing), so that other tests never see it.
+*/
+func registerTestGenerator(test *testing.T, language string, generator Generator) {
+
+	var previous Generator
+	var found bool
+	var err error
+
+	previous, found = GetGenerator(language)
+
+	err = RegisterGenerator(language, generator)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	test.Cleanup(func() {
+
+		if found {
+			RegisterGenerator(language, previous)
+			return
+		}
+
+		generatorLock.Lock()
+		defer generatorLock.Unlock()
+		delete(generators, language)
+	})
+}
@@ -2,19 +2,38 @@ package presilo
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+/*
+  The storage width needed to hold every value an integer schema allows.
+*/
+type IntegerWidth int
+
+const (
+	INTEGERWIDTH_32 IntegerWidth = iota
+	INTEGERWIDTH_64
+	INTEGERWIDTH_BIG
 )
 
 /*
   A schema which describes an integer.
+  Bounds are kept as arbitrary-precision integers, so that no value is ever truncated or rounded while parsing.
 */
 type IntegerSchema struct {
 	Schema
-	Minimum          *int   `json:"minimum"`
-	Maximum          *int   `json:"maximum"`
-	ExclusiveMaximum *bool  `json:"exclusiveMaximum"`
-	ExclusiveMinimum *bool  `json:"exclusiveMinimum"`
-	MultipleOf       *int   `json:"multipleOf"`
-	Enum             *[]int `json:"enum"`
+	Minimum          *big.Int    `json:"minimum"`
+	Maximum          *big.Int    `json:"maximum"`
+	ExclusiveMaximum *bool       `json:"exclusiveMaximum"`
+	ExclusiveMinimum *bool       `json:"exclusiveMinimum"`
+	MultipleOf       *big.Int    `json:"multipleOf"`
+	Enum             *[]*big.Int `json:"enum"`
+	Const            *big.Int    `json:"const"`
+	Format           string      `json:"format"`
+	width            IntegerWidth
 }
 
 func NewIntegerSchema() *IntegerSchema {
@@ -39,14 +58,122 @@ func ParseIntegerSchema(contents []byte, context *SchemaParseContext) (*IntegerS
 		return ret, err
 	}
 
+	// a const is just an enum with one value.
+	if ret.Const != nil {
+		ret.Enum = &[]*big.Int{ret.Const}
+	}
+
+	ret.width, err = ret.determineWidth()
+	if err != nil {
+		return ret, err
+	}
+
 	return ret, nil
 }
 
+/*
+	Determines the width of this schema.
+	An explicit "format" of "int32" or "int64" is used as-is (so long as the bounds fit inside it),
+	"bigint" opts in to arbitrary precision, and otherwise the smallest width which fits every bound is used.
+*/
+func (this *IntegerSchema) determineWidth() (IntegerWidth, error) {
+
+	var required IntegerWidth
+	var widest *big.Int
+
+	required = INTEGERWIDTH_32
+
+	for _, bound := range this.getBounds() {
+
+		if !bound.IsInt64() {
+			required = INTEGERWIDTH_BIG
+			widest = bound
+			break
+		}
+
+		if bound.Int64() > math.MaxInt32 || bound.Int64() < math.MinInt32 {
+			required = INTEGERWIDTH_64
+		}
+	}
+
+	switch this.Format {
+	case "bigint":
+		return INTEGERWIDTH_BIG, nil
+	case "int64":
+		if required > INTEGERWIDTH_64 {
+			return required, errors.New("Integer bounds do not fit in the specified format 'int64'")
+		}
+		return INTEGERWIDTH_64, nil
+	case "int32":
+		if required > INTEGERWIDTH_32 {
+			return required, errors.New("Integer bounds do not fit in the specified format 'int32'")
+		}
+		return INTEGERWIDTH_32, nil
+	}
+
+	if required == INTEGERWIDTH_BIG {
+		errorMsg := fmt.Sprintf("Integer value '%s' does not fit in 64 bits, use the 'bigint' format to allow arbitrary precision", widest.String())
+		return required, errors.New(errorMsg)
+	}
+
+	return required, nil
+}
+
+/*
+	Widens this schema to match the given [owner], whose value this schema constrains.
+	Returns an error if this schema's bounds don't fit in the owner's width.
+*/
+func (this *IntegerSchema) matchWidth(owner *IntegerSchema) error {
+
+	if this.width > owner.width {
+		errorMsg := fmt.Sprintf("Integer bounds of a subschema of '%s' do not fit in the width of the schema it constrains", owner.GetTitle())
+		return errors.New(errorMsg)
+	}
+
+	this.width = owner.width
+	return nil
+}
+
+/*
+	Returns every value which this schema's constraints mention.
+*/
+func (this *IntegerSchema) getBounds() []*big.Int {
+
+	var ret []*big.Int
+
+	for _, bound := range []*big.Int{this.Minimum, this.Maximum, this.MultipleOf} {
+		if bound != nil {
+			ret = append(ret, bound)
+		}
+	}
+
+	if this.Enum != nil {
+		ret = append(ret, *this.Enum...)
+	}
+
+	return ret
+}
+
+/*
+	Returns the width needed to store any value of this schema.
+*/
+func (this *IntegerSchema) GetWidth() IntegerWidth {
+	return this.width
+}
+
+/*
+	Returns true if this schema needs arbitrary-precision integers.
+*/
+func (this *IntegerSchema) IsBig() bool {
+	return this.width == INTEGERWIDTH_BIG
+}
+
 func (this *IntegerSchema) HasConstraints() bool {
 	return this.Minimum != nil ||
 		this.Maximum != nil ||
 		this.MultipleOf != nil ||
-		this.Enum != nil
+		this.Enum != nil ||
+		this.Not != nil
 }
 
 func (this *IntegerSchema) HasMinimum() bool {
@@ -66,21 +193,21 @@ func (this *IntegerSchema) HasMultiple() bool {
 }
 
 func (this *IntegerSchema) GetMinimum() interface{} {
-	return *this.Minimum
+	return this.Minimum
 }
 
 func (this *IntegerSchema) GetMaximum() interface{} {
-	return *this.Maximum
+	return this.Maximum
 }
 
 func (this *IntegerSchema) GetMultiple() interface{} {
-	return *this.MultipleOf
+	return this.MultipleOf
 }
 
 func (this *IntegerSchema) GetEnum() []interface{} {
 
 	var ret []interface{}
-	var enumValues []int
+	var enumValues []*big.Int
 	var length int
 
 	length = len(*this.Enum)
@@ -2,10 +2,24 @@ package presilo
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+/*
+  The largest precision and scale a decimal schema may use, which are also the defaults.
+  These match the limits of MySQL's DECIMAL type, the narrowest of the supported languages.
+*/
+const (
+	DECIMAL_MAX_PRECISION = 65
+	DECIMAL_MAX_SCALE     = 30
 )
 
 /*
   A schema which describes a number, which may include floating point numbers.
+  Numbers are floats unless the schema has the "decimal" format (or a precision or scale),
+  in which case they're exact decimals, and their constraints are kept exactly as written.
 */
 type NumberSchema struct {
 	Schema
@@ -15,6 +29,23 @@ type NumberSchema struct {
 	ExclusiveMaximum *bool      `json:"exclusiveMaximum"`
 	MultipleOf       *float64   `json:"multipleOf"`
 	Enum             *[]float64 `json:"enum"`
+	Const            *float64   `json:"const"`
+	Format           string     `json:"format"`
+	Precision        *int       `json:"x-precision"`
+	Scale            *int       `json:"x-scale"`
+	decimal          bool
+	decimals         decimalConstraints
+}
+
+/*
+  The constraints of a number schema, as the literal text which was written in the schema.
+*/
+type decimalConstraints struct {
+	Minimum    *json.Number   `json:"minimum"`
+	Maximum    *json.Number   `json:"maximum"`
+	MultipleOf *json.Number   `json:"multipleOf"`
+	Enum       *[]json.Number `json:"enum"`
+	Const      *json.Number   `json:"const"`
 }
 
 func NewNumberSchema() *NumberSchema {
@@ -39,14 +70,161 @@ func ParseNumberSchema(contents []byte, context *SchemaParseContext) (*NumberSch
 		return ret, err
 	}
 
+	err = json.Unmarshal(contents, &ret.decimals)
+	if err != nil {
+		return ret, err
+	}
+
+	// a const is just an enum with one value.
+	if ret.Const != nil {
+		ret.Enum = &[]float64{*ret.Const}
+		ret.decimals.Enum = &[]json.Number{*ret.decimals.Const}
+	}
+
+	ret.decimal = ret.Format == "decimal" || ret.Precision != nil || ret.Scale != nil
+
+	err = ret.checkPrecision()
+	if err != nil {
+		return ret, err
+	}
+
 	return ret, nil
 }
 
+/*
+	Returns an error if this schema's precision or scale are out of range,
+	or if any of its constraints can't be represented with them.
+*/
+func (this *NumberSchema) checkPrecision() error {
+
+	var precision, scale int
+	var errorMsg string
+
+	if !this.decimal {
+		return nil
+	}
+
+	precision = this.GetPrecision()
+	scale = this.GetScale()
+
+	if precision < 1 || precision > DECIMAL_MAX_PRECISION || scale < 0 || scale > DECIMAL_MAX_SCALE || scale > precision {
+		errorMsg = fmt.Sprintf("Decimal schema has precision %d and scale %d, precision must be 1-%d and scale must be 0-%d (and no more than precision)",
+			precision, scale, DECIMAL_MAX_PRECISION, DECIMAL_MAX_SCALE)
+		return errors.New(errorMsg)
+	}
+
+	for _, bound := range this.getDecimalBounds() {
+
+		if !fitsDecimal(bound, precision, scale) {
+			errorMsg = fmt.Sprintf("Decimal value '%s' does not fit in precision %d and scale %d", bound, precision, scale)
+			return errors.New(errorMsg)
+		}
+	}
+
+	return nil
+}
+
+/*
+	Returns every value which this schema's constraints mention, as written.
+*/
+func (this *NumberSchema) getDecimalBounds() []json.Number {
+
+	var ret []json.Number
+
+	for _, bound := range []*json.Number{this.decimals.Minimum, this.decimals.Maximum, this.decimals.MultipleOf} {
+		if bound != nil {
+			ret = append(ret, *bound)
+		}
+	}
+
+	if this.decimals.Enum != nil {
+		ret = append(ret, *this.decimals.Enum...)
+	}
+
+	return ret
+}
+
+/*
+	Returns true if the given [value] can be exactly represented by a decimal with the given [precision] and [scale].
+*/
+func fitsDecimal(value json.Number, precision int, scale int) bool {
+
+	var rational *big.Rat
+	var scaled *big.Int
+	var exponent *big.Int
+
+	rational, _ = new(big.Rat).SetString(string(value))
+	if rational == nil {
+		return false
+	}
+
+	// scaling by 10^scale must leave a whole number, with no more than [precision] digits.
+	exponent = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	rational.Mul(rational, new(big.Rat).SetInt(exponent))
+
+	if !rational.IsInt() {
+		return false
+	}
+
+	scaled = new(big.Int).Abs(rational.Num())
+	return len(scaled.String()) <= precision
+}
+
+/*
+	Makes this schema (used to constrain the value of the given [owner]) decimal if its owner is.
+*/
+func (this *NumberSchema) matchDecimal(owner *NumberSchema) error {
+
+	if !owner.decimal {
+		return nil
+	}
+
+	this.decimal = true
+	this.Precision = owner.Precision
+	this.Scale = owner.Scale
+	return this.checkPrecision()
+}
+
+/*
+	Returns true if this schema represents exact decimals, rather than floats.
+*/
+func (this *NumberSchema) IsDecimal() bool {
+	return this.decimal
+}
+
+/*
+	Returns the total number of digits a decimal schema can hold.
+*/
+func (this *NumberSchema) GetPrecision() int {
+
+	if this.Precision == nil {
+		return DECIMAL_MAX_PRECISION
+	}
+	return *this.Precision
+}
+
+/*
+	Returns the number of digits after the decimal point that a decimal schema can hold.
+	As in SQL, a precision given without a scale means a scale of 0.
+*/
+func (this *NumberSchema) GetScale() int {
+
+	if this.Scale != nil {
+		return *this.Scale
+	}
+
+	if this.Precision != nil {
+		return 0
+	}
+	return DECIMAL_MAX_SCALE
+}
+
 func (this *NumberSchema) HasConstraints() bool {
 	return this.Minimum != nil ||
 		this.Maximum != nil ||
 		this.MultipleOf != nil ||
-		this.Enum != nil
+		this.Enum != nil ||
+		this.Not != nil
 }
 
 func (this *NumberSchema) HasMinimum() bool {
@@ -65,15 +243,31 @@ func (this *NumberSchema) HasEnum() bool {
 	return this.Enum != nil
 }
 
+/*
+	Returns the minimum of this schema; a float64, or a json.Number for decimal schemas.
+	The same is true of the other constraint getters.
+*/
 func (this *NumberSchema) GetMinimum() interface{} {
+
+	if this.decimal {
+		return *this.decimals.Minimum
+	}
 	return *this.Minimum
 }
 
 func (this *NumberSchema) GetMaximum() interface{} {
+
+	if this.decimal {
+		return *this.decimals.Maximum
+	}
 	return *this.Maximum
 }
 
 func (this *NumberSchema) GetMultiple() interface{} {
+
+	if this.decimal {
+		return *this.decimals.MultipleOf
+	}
 	return *this.MultipleOf
 }
 
@@ -83,6 +277,14 @@ func (this *NumberSchema) GetEnum() []interface{} {
 	var enumValues []float64
 	var length int
 
+	if this.decimal {
+
+		for _, enumValue := range *this.decimals.Enum {
+			ret = append(ret, enumValue)
+		}
+		return ret
+	}
+
 	length = len(*this.Enum)
 	ret = make([]interface{}, length)
 	enumValues = *this.Enum
@@ -102,5 +304,9 @@ func (this *NumberSchema) IsExclusiveMinimum() bool {
 }
 
 func (this *NumberSchema) GetConstraintFormat() string {
+
+	if this.decimal {
+		return "%s"
+	}
 	return "%f"
 }
@@ -20,8 +20,22 @@ type ObjectSchema struct {
 	// NOT SUPPORTED: patternProperties
 	RawProperties map[string]*json.RawMessage `json:"properties"`
 
+	// The names of all properties, in the order they were declared (or sorted, see SortProperties).
+	PropertyOrder []string `json:"-"`
+
+	// Cross-field constraints, which can only be checked against the object as a whole.
+	If                *ConditionalSchema            `json:"if"`
+	Then              *ConditionalSchema            `json:"then"`
+	Else              *ConditionalSchema            `json:"else"`
+	DependentRequired map[string][]string           `json:"dependentRequired"`
+	DependentSchemas  map[string]*ConditionalSchema `json:"dependentSchemas"`
+	RawDependencies   map[string]*json.RawMessage   `json:"dependencies"`
+
 	ConstrainedProperties   SortableStringArray
 	UnconstrainedProperties SortableStringArray
+
+	// A hash of the normalized schema this was parsed from, see GetSchemaHash.
+	SchemaHash string `json:"-"`
 }
 
 func NewObjectSchema() *ObjectSchema {
@@ -42,6 +56,7 @@ func ParseObjectSchema(contents []byte, context *SchemaParseContext) (*ObjectSch
 
 	var ret *ObjectSchema
 	var sub TypeSchema
+	var rawContents map[string]*json.RawMessage
 	var subschemaBytes []byte
 	var err error
 
@@ -57,10 +72,26 @@ func ParseObjectSchema(contents []byte, context *SchemaParseContext) (*ObjectSch
 		return ret, err
 	}
 
+	// json maps are unordered, so find the order properties were declared in separately.
+	err = json.Unmarshal(contents, &rawContents)
+	if err != nil {
+		return ret, err
+	}
+
+	ret.PropertyOrder, err = getJsonKeyOrder(rawContents["properties"])
+	if err != nil {
+		return ret, err
+	}
+
+	ret.SchemaHash, err = getNormalizedSchemaHash(contents)
+	if err != nil {
+		return ret, err
+	}
+
 	// parse individual sub-schemas
-	for propertyName, propertyContents := range ret.RawProperties {
+	for _, propertyName := range ret.PropertyOrder {
 
-		subschemaBytes, err = propertyContents.MarshalJSON()
+		subschemaBytes, err = ret.RawProperties[propertyName].MarshalJSON()
 		if err != nil {
 			return ret, err
 		}
@@ -73,49 +104,98 @@ func ParseObjectSchema(contents []byte, context *SchemaParseContext) (*ObjectSch
 		ret.Properties[propertyName] = sub
 	}
 
+	err = ret.parseConditions()
+	if err != nil {
+		return ret, err
+	}
+
 	// for convenience, populate "ConstrainedProperties" to all required properties,
 	// along with any other properties which have constraints
-	for propertyName, subschema := range ret.Properties {
+	for _, propertyName := range ret.PropertyOrder {
 
-		if subschema.HasConstraints() {
+		if ret.Properties[propertyName].HasConstraints() {
 			ret.ConstrainedProperties = append(ret.ConstrainedProperties, propertyName)
 		} else {
 			ret.UnconstrainedProperties = append(ret.UnconstrainedProperties, propertyName)
 		}
 	}
 
-	ret.ConstrainedProperties.Sort()
-	ret.UnconstrainedProperties.Sort()
 	return ret, nil
 }
 
+/*
+	Returns a hash (sha256, in hex) of the normalized json this schema was parsed from; whitespace and key order don't change it.
+	Empty if this schema wasn't parsed.
+*/
+func (this *ObjectSchema) GetSchemaHash() string {
+	return this.SchemaHash
+}
+
+/*
+	Adds the given property to this schema, after any which already exist.
+*/
 func (this *ObjectSchema) AddProperty(name string, schema TypeSchema) {
 
+	if _, found := this.Properties[name]; !found {
+		this.PropertyOrder = append(this.PropertyOrder, name)
+	}
+
 	this.Properties[name] = schema
 
 	if(schema.HasConstraints()) {
 		this.ConstrainedProperties = append(this.ConstrainedProperties, name)
-		this.ConstrainedProperties.Sort()
 	} else {
 		this.UnconstrainedProperties = append(this.UnconstrainedProperties, name)
-		this.UnconstrainedProperties.Sort()
 	}
 }
 
 /*
 	Returns an ordered array of property names, guaranteed to be the same for the same schema input over multiple runs of the program.
+	Properties are in the order they were declared, unless SortProperties has been used.
+	Any properties which were put directly into the Properties map come last, alphabetically.
 */
 func (this *ObjectSchema) GetOrderedPropertyNames() []string {
 
-	var ret SortableStringArray
+	var ret []string
+	var remaining SortableStringArray
+
+	for _, key := range this.PropertyOrder {
+
+		if _, found := this.Properties[key]; found {
+			ret = append(ret, key)
+		}
+	}
 
-	// fill
 	for key, _ := range this.Properties {
-		ret = append(ret, key)
+
+		if !arrayContainsString(ret, key) {
+			remaining = append(remaining, key)
+		}
 	}
 
-	ret.Sort()
-	return ret
+	remaining.Sort()
+	return append(ret, remaining...)
+}
+
+/*
+	Orders the properties of this schema (and its conditions) alphabetically, rather than in the order they were declared.
+	Useful to keep generated code stable when the properties of a schema are often reordered.
+	The order of "required" (and so of constructor parameters) is left as-is.
+*/
+func (this *ObjectSchema) SortProperties() {
+
+	var order SortableStringArray
+
+	order = this.GetOrderedPropertyNames()
+	order.Sort()
+	this.PropertyOrder = order
+
+	this.ConstrainedProperties.Sort()
+	this.UnconstrainedProperties.Sort()
+
+	for _, condition := range getConditionalSchemas(this) {
+		condition.order(this)
+	}
 }
 
 func (this *ObjectSchema) checkRequiredProperties() error {
@@ -139,3 +219,158 @@ func (this *ObjectSchema) checkRequiredProperties() error {
 func (this *ObjectSchema) HasConstraints() bool {
 	return false
 }
+
+/*
+	Returns true if this schema has any constraints which span multiple properties,
+	and so need to be checked against the object as a whole.
+*/
+func (this *ObjectSchema) HasConditions() bool {
+	return (this.If != nil && (this.Then != nil || this.Else != nil)) ||
+		len(this.DependentRequired) > 0 ||
+		len(this.DependentSchemas) > 0
+}
+
+/*
+	Returns an ordered array of all property names which have dependentRequired or dependentSchemas constraints,
+	in the same order as GetOrderedPropertyNames.
+*/
+func (this *ObjectSchema) GetOrderedDependencyNames() []string {
+
+	var ret []string
+	var found bool
+
+	for _, key := range this.GetOrderedPropertyNames() {
+
+		_, found = this.DependentRequired[key]
+		if !found {
+			_, found = this.DependentSchemas[key]
+		}
+
+		if found {
+			ret = append(ret, key)
+		}
+	}
+
+	return ret
+}
+
+/*
+	Parses the if/then/else and dependency subschemas of this object,
+	folding the older "dependencies" keyword into dependentRequired and dependentSchemas.
+	Dependencies are checked by property name, alphabetically, so the same schema always fails with the same error.
+*/
+func (this *ObjectSchema) parseConditions() error {
+
+	var requiredNames, dependencies []string
+	var propertyNames SortableStringArray
+	var contents *json.RawMessage
+	var dependentSchema *ConditionalSchema
+	var found bool
+	var err error
+
+	for _, propertyName := range getSortedRawKeys(this.RawDependencies) {
+
+		contents = this.RawDependencies[propertyName]
+
+		// "dependencies" is either an array of required names, or a schema.
+		err = json.Unmarshal(*contents, &requiredNames)
+		if err == nil {
+
+			if this.DependentRequired == nil {
+				this.DependentRequired = make(map[string][]string)
+			}
+			this.DependentRequired[propertyName] = requiredNames
+			requiredNames = nil
+			continue
+		}
+
+		dependentSchema = new(ConditionalSchema)
+
+		err = json.Unmarshal(*contents, dependentSchema)
+		if err != nil {
+			errorMsg := fmt.Sprintf("Dependency for '%s' must be either an array of property names or a schema\n", propertyName)
+			return errors.New(errorMsg)
+		}
+
+		if this.DependentSchemas == nil {
+			this.DependentSchemas = make(map[string]*ConditionalSchema)
+		}
+		this.DependentSchemas[propertyName] = dependentSchema
+	}
+
+	for propertyName, _ := range this.DependentRequired {
+		propertyNames = append(propertyNames, propertyName)
+	}
+	propertyNames.Sort()
+
+	for _, propertyName := range propertyNames {
+
+		dependencies = this.DependentRequired[propertyName]
+
+		_, found = this.RawProperties[propertyName]
+		if !found {
+			errorMsg := fmt.Sprintf("Property '%s' has dependencies, but was not defined\n", propertyName)
+			return errors.New(errorMsg)
+		}
+
+		for _, dependency := range dependencies {
+
+			_, found = this.RawProperties[dependency]
+			if !found {
+				errorMsg := fmt.Sprintf("Property '%s' was listed as a dependency of '%s', but was not defined\n", dependency, propertyName)
+				return errors.New(errorMsg)
+			}
+		}
+	}
+
+	propertyNames = nil
+	for propertyName, _ := range this.DependentSchemas {
+		propertyNames = append(propertyNames, propertyName)
+	}
+	propertyNames.Sort()
+
+	for _, propertyName := range propertyNames {
+
+		dependentSchema = this.DependentSchemas[propertyName]
+
+		_, found = this.RawProperties[propertyName]
+		if !found {
+			errorMsg := fmt.Sprintf("Property '%s' has a dependent schema, but was not defined\n", propertyName)
+			return errors.New(errorMsg)
+		}
+
+		err = dependentSchema.parseProperties(this)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, condition := range []*ConditionalSchema{this.If, this.Then, this.Else} {
+
+		if condition == nil {
+			continue
+		}
+
+		err = condition.parseProperties(this)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+	Returns the keys of the given [raw] json object, alphabetically.
+*/
+func getSortedRawKeys(raw map[string]*json.RawMessage) SortableStringArray {
+
+	var ret SortableStringArray
+
+	for key, _ := range raw {
+		ret = append(ret, key)
+	}
+
+	ret.Sort()
+	return ret
+}
@@ -0,0 +1,127 @@
+package presilo
+
+import (
+	"strings"
+	"testing"
+)
+
+/*
+	An object whose properties (and conditions, and dependencies) are declared out of alphabetical order.
+*/
+const orderedTestSchema = `{
+	"title": "Ordered",
+	"type": "object",
+	"properties": {
+		"zeta": {"type": "string", "minLength": 1},
+		"alpha": {"type": "integer"},
+		"mid": {"type": "string", "maxLength": 3},
+		"beta": {"type": "string"}
+	},
+	"required": ["mid", "zeta"],
+	"if": {"properties": {"mid": {"const": "a"}, "zeta": {"const": "z"}}},
+	"then": {"required": ["alpha"]},
+	"dependentRequired": {"zeta": ["beta"], "alpha": ["mid"]}
+}`
+
+func TestPropertyDeclarationOrder(test *testing.T) {
+
+	var schema *ObjectSchema
+	var code string
+	var err error
+
+	schema, err = parseOrderedTestSchema(NewSchemaParseContext())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	assertTestOrder(test, "properties", schema.GetOrderedPropertyNames(), "zeta,alpha,mid,beta")
+	assertTestOrder(test, "condition properties", schema.If.GetOrderedPropertyNames(), "zeta,mid")
+	assertTestOrder(test, "dependencies", schema.GetOrderedDependencyNames(), "zeta,alpha")
+	assertTestOrder(test, "constrained properties", schema.ConstrainedProperties, "zeta,mid")
+	assertTestOrder(test, "required properties", schema.RequiredProperties, "mid,zeta")
+
+	// generated fields follow the same order.
+	code = GenerateGo(schema, "ordered", "\t", GeneratorOptions{})
+	if !(strings.Index(code, "\tZeta ") < strings.Index(code, "\tAlpha ") && strings.Index(code, "\tAlpha ") < strings.Index(code, "\tBeta ")) {
+		test.Errorf("Expected fields in declaration order, but got:\n%s", code)
+	}
+}
+
+func TestSortProperties(test *testing.T) {
+
+	var context *SchemaParseContext
+	var schema, other *ObjectSchema
+	var err error
+
+	context = NewSchemaParseContext()
+
+	schema, err = parseOrderedTestSchema(context)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	context.SortProperties()
+
+	assertTestOrder(test, "properties", schema.GetOrderedPropertyNames(), "alpha,beta,mid,zeta")
+	assertTestOrder(test, "condition properties", schema.If.GetOrderedPropertyNames(), "mid,zeta")
+	assertTestOrder(test, "dependencies", schema.GetOrderedDependencyNames(), "alpha,zeta")
+	assertTestOrder(test, "constrained properties", schema.ConstrainedProperties, "mid,zeta")
+
+	// constructor parameters keep the order of "required".
+	assertTestOrder(test, "required properties", schema.RequiredProperties, "mid,zeta")
+
+	// sorting one schema leaves every other schema as it was declared.
+	schema, err = parseOrderedTestSchema(NewSchemaParseContext())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	other, err = parseOrderedTestSchema(NewSchemaParseContext())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	schema.SortProperties()
+
+	assertTestOrder(test, "sorted properties", schema.GetOrderedPropertyNames(), "alpha,beta,mid,zeta")
+	assertTestOrder(test, "unsorted properties", other.GetOrderedPropertyNames(), "zeta,alpha,mid,beta")
+}
+
+func TestParseConditionErrorOrder(test *testing.T) {
+
+	var err error
+
+	// both dependencies are undefined, and the same one must be reported every time.
+	for i := 0; i < 20; i++ {
+
+		_, err = ParseSchema([]byte(`{
+			"title": "Thing",
+			"type": "object",
+			"properties": {"name": {"type": "string"}},
+			"dependentRequired": {"zeta": ["name"], "beta": ["name"], "alpha": ["name"]}
+		}`), "thing", NewSchemaParseContext())
+
+		if err == nil || !strings.Contains(err.Error(), "'alpha'") {
+			test.Fatalf("Expected the first undefined dependency, alphabetically, to be reported, but got: %v", err)
+		}
+	}
+}
+
+func parseOrderedTestSchema(context *SchemaParseContext) (*ObjectSchema, error) {
+
+	var schema TypeSchema
+	var err error
+
+	schema, err = ParseSchema([]byte(orderedTestSchema), "ordered", context)
+	if err != nil {
+		return nil, err
+	}
+	return schema.(*ObjectSchema), nil
+}
+
+func assertTestOrder(test *testing.T, name string, actual []string, expected string) {
+
+	if strings.Join(actual, ",") != expected {
+		test.Errorf("Expected %s in the order [%s], but got %v", name, expected, actual)
+	}
+}
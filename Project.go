@@ -0,0 +1,386 @@
+package presilo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+	The names of the project file RunProjectFile looks for, when given a directory; json first, then YAML.
+*/
+const (
+	PROJECT_FILE_NAME      = "presilo.json"
+	PROJECT_YAML_FILE_NAME = "presilo.yaml"
+)
+
+/*
+	The ways a target can name the file of each type, instead of the way its generator does (see ProjectTarget.Naming).
+	Only the last part of each path is renamed; directories (such as a Java package) are left alone.
+*/
+const (
+	// "WidgetPart"
+	NAMING_CAMEL = "camel"
+
+	// "widgetPart"
+	NAMING_JAVA = "java"
+
+	// "widget_part"
+	NAMING_SNAKE = "snake"
+)
+
+var fileNamings = map[string]func(string) string{
+	NAMING_CAMEL: ToStrictCamelCase,
+	NAMING_JAVA:  ToStrictJavaCase,
+	NAMING_SNAKE: ToStrictSnakeCase,
+}
+
+/*
+	A project; a set of schemas, and every target which code should be generated for from them.
+*/
+type Project struct {
+
+	// Schema files, directories (every ".json" file beneath them), or globs.
+	Inputs []string `json:"inputs"`
+
+	Targets []*ProjectTarget `json:"targets"`
+
+	// The directory which relative input and output paths are resolved against. Set by LoadProject to the project file's directory.
+	Root string `json:"-"`
+}
+
+/*
+	One language which code is generated for, and the same options given to WriteGeneratedCode.
+*/
+type ProjectTarget struct {
+	Language     string `json:"language"`
+	Module       string `json:"module"`
+	Output       string `json:"output"`
+	Tabstyle     string `json:"tabstyle"`
+	SplitFiles   bool   `json:"splitFiles"`
+	UnsafeModule bool   `json:"unsafeModule"`
+
+	// How the file of each type is named, when files are split; one of the NAMING_* constants.
+	// Empty names files the way the generator does. Languages whose files load each other by name can't be renamed.
+	Naming string `json:"naming"`
+
+	Options GeneratorOptions `json:"options"`
+}
+
+/*
+	An error encountered while generating one target of a project.
+*/
+type ProjectTargetError struct {
+	Target *ProjectTarget
+	Err    error
+}
+
+func (this *ProjectTargetError) Error() string {
+	return fmt.Sprintf("%s (%s): %s", this.Target.Language, this.Target.Output, this.Err.Error())
+}
+
+/*
+	Every error encountered while generating a project, one per target which failed.
+*/
+type ProjectTargetErrors []*ProjectTargetError
+
+func (this ProjectTargetErrors) Error() string {
+
+	var errs []error
+
+	for _, err := range this {
+		errs = append(errs, err)
+	}
+	return joinErrors(errs)
+}
+
+/*
+	Reads the project file at the given [path]. If [path] is a directory, the PROJECT_FILE_NAME inside it is read,
+	or the PROJECT_YAML_FILE_NAME if there's no json file. Files ending in ".yaml" or ".yml" are read as YAML (see ParseProjectYAML).
+	Relative paths in the project are relative to the directory the project file is in.
+*/
+func LoadProject(path string) (*Project, error) {
+
+	var ret *Project
+	var info os.FileInfo
+	var contents []byte
+	var err error
+
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err = os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+
+		path = filepath.Join(path, PROJECT_FILE_NAME)
+
+		_, err = os.Stat(path)
+		if os.IsNotExist(err) {
+			path = filepath.Join(filepath.Dir(path), PROJECT_YAML_FILE_NAME)
+		}
+	}
+
+	contents, err = ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		ret, err = ParseProjectYAML(contents)
+	default:
+		ret, err = ParseProject(contents)
+	}
+
+	if err != nil {
+		return nil, &SchemaFileError{Path: path, Err: err}
+	}
+
+	ret.Root = filepath.Dir(path)
+	return ret, nil
+}
+
+/*
+	Parses a project from the given json [contents]. Unknown keys are errors, so that typos don't silently go unused.
+*/
+func ParseProject(contents []byte) (*Project, error) {
+
+	var ret *Project
+	var decoder *json.Decoder
+	var err error
+
+	decoder = json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	if ret == nil || len(ret.Inputs) == 0 {
+		return nil, errors.New("Project has no inputs")
+	}
+
+	if len(ret.Targets) == 0 {
+		return nil, errors.New("Project has no targets")
+	}
+
+	for i, target := range ret.Targets {
+
+		if target == nil || len(target.Language) == 0 || len(target.Output) == 0 {
+			errorMsg := fmt.Sprintf("Target %d must have a language and an output", i)
+			return nil, errors.New(errorMsg)
+		}
+
+		if len(target.Tabstyle) == 0 {
+			target.Tabstyle = "\t"
+		}
+
+		if _, found := fileNamings[target.Naming]; !found && len(target.Naming) > 0 {
+			errorMsg := fmt.Sprintf("Target %d has unknown naming '%s'", i, target.Naming)
+			return nil, errors.New(errorMsg)
+		}
+	}
+
+	return ret, nil
+}
+
+/*
+	Parses a project from the given YAML [contents], which has the same keys as a json project (see ParseProject).
+	Only the YAML which configuration needs is understood; see parseYAML.
+*/
+func ParseProjectYAML(contents []byte) (*Project, error) {
+
+	var value interface{}
+	var converted []byte
+	var err error
+
+	value, err = parseYAML(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, err = json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseProject(converted)
+}
+
+/*
+	Loads the project at the given [path] (see LoadProject), and generates every one of its targets (see Project.Run).
+*/
+func RunProjectFile(ctx context.Context, path string) error {
+
+	var project *Project
+	var err error
+
+	project, err = LoadProject(path)
+	if err != nil {
+		return err
+	}
+
+	return project.Run(ctx)
+}
+
+/*
+	Parses every input of this project once, then generates code for every target.
+	A target which fails doesn't stop the others; the returned error is a ProjectTargetErrors, with one entry per target which failed.
+*/
+func (this *Project) Run(ctx context.Context) error {
+
+	var context *SchemaParseContext
+	var err error
+
+	context, err = this.Parse()
+	if err != nil {
+		return err
+	}
+
+	return this.generate(ctx, context, nil)
+}
+
+/*
+	Generates code for every target from the given (already parsed) [context].
+	If [only] isn't nil, split targets only write the files of the schemas whose IDs are in it (see generateCode).
+*/
+func (this *Project) generate(ctx context.Context, context *SchemaParseContext, only map[string]bool) error {
+
+	var targetErrors ProjectTargetErrors
+	var output string
+	var found bool
+	var err error
+
+	for _, target := range this.Targets {
+
+		err = ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		// don't leave an empty output directory behind for a language which can't be generated.
+		_, found = GetGenerator(target.Language)
+		if !found {
+			targetErrors = append(targetErrors, &ProjectTargetError{Target: target, Err: errors.New("No valid language specified")})
+			continue
+		}
+
+		output = this.resolvePath(target.Output)
+
+		err = os.MkdirAll(output, 0755)
+		if err == nil {
+			err = writeGeneratedCode(ctx, context, target.Module, output, target.Language, target.Tabstyle, target.UnsafeModule, target.SplitFiles, target.Options, only, fileNamings[target.Naming])
+		}
+
+		if err != nil {
+			targetErrors = append(targetErrors, &ProjectTargetError{Target: target, Err: err})
+		}
+	}
+
+	if len(targetErrors) > 0 {
+		return targetErrors
+	}
+	return nil
+}
+
+/*
+	Parses every input of this project into one context, and links them.
+	Sources are named relative to the project's root.
+*/
+func (this *Project) Parse() (*SchemaParseContext, error) {
+
+	var context *SchemaParseContext
+	var paths []string
+	var err error
+
+	paths, err = this.getInputPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	context = NewSchemaParseContext()
+	context.SourceRoot = this.resolvePath(".")
+
+	err = ParseSchemaFilesContinue(paths, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return context, LinkSchemas(context)
+}
+
+/*
+	Returns the path of every schema file named by this project's inputs, with globs and directories expanded.
+*/
+func (this *Project) getInputPaths() ([]string, error) {
+
+	var paths, matches []string
+	var info os.FileInfo
+	var input string
+	var err error
+
+	for _, input = range this.Inputs {
+
+		input = this.resolvePath(input)
+
+		if strings.ContainsAny(input, "*?[") {
+
+			matches, err = filepath.Glob(input)
+			if err != nil {
+				return nil, err
+			}
+
+			paths = append(paths, matches...)
+			continue
+		}
+
+		info, err = os.Stat(input)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			paths = append(paths, input)
+			continue
+		}
+
+		err = filepath.Walk(input, func(path string, info os.FileInfo, err error) error {
+
+			if err != nil {
+				return err
+			}
+
+			if !info.IsDir() && strings.EqualFold(filepath.Ext(path), ".json") {
+				paths = append(paths, path)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return paths, nil
+}
+
+func (this *Project) resolvePath(path string) string {
+
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(this.Root, filepath.FromSlash(path))
+}
@@ -0,0 +1,277 @@
+package presilo
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+/*
+	A sample project, with the same project in both json and YAML.
+*/
+const projectDirectory = "testdata/project"
+
+func TestLoadProject(test *testing.T) {
+
+	var project *Project
+	var err error
+
+	project, err = LoadProject(filepath.Join(projectDirectory, PROJECT_FILE_NAME))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if len(project.Inputs) != 1 || project.Inputs[0] != "schemas" {
+		test.Errorf("Expected one input, 'schemas', but got %v", project.Inputs)
+	}
+
+	if len(project.Targets) != 3 {
+		test.Fatalf("Expected three targets, but got %d", len(project.Targets))
+	}
+
+	if project.Targets[0].Naming != NAMING_SNAKE || !project.Targets[0].Options.AllAccessors || !project.Targets[0].SplitFiles {
+		test.Errorf("The go target wasn't loaded with all of its options: %+v", project.Targets[0])
+	}
+
+	if project.Targets[1].Tabstyle != "\t" || project.Targets[2].Tabstyle != "  " {
+		test.Errorf("Tabstyles should default to a tab, unless given")
+	}
+
+	if !filepath.IsAbs(project.Root) || filepath.Base(project.Root) != "project" {
+		test.Errorf("Project root should be the directory of its file, but was '%s'", project.Root)
+	}
+}
+
+func TestLoadProjectYAML(test *testing.T) {
+
+	var fromJSON, fromYAML *Project
+	var err error
+
+	fromJSON, err = LoadProject(filepath.Join(projectDirectory, PROJECT_FILE_NAME))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	fromYAML, err = LoadProject(filepath.Join(projectDirectory, PROJECT_YAML_FILE_NAME))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(fromJSON, fromYAML) {
+		test.Errorf("The YAML project should be the same as the json one")
+	}
+}
+
+func TestLoadProjectDirectory(test *testing.T) {
+
+	var directory string
+	var project *Project
+	var contents []byte
+	var err error
+
+	// json is preferred, when a directory has both.
+	project, err = LoadProject(projectDirectory)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if len(project.Targets) != 3 {
+		test.Errorf("Expected the project in '%s' to be loaded", projectDirectory)
+	}
+
+	// and YAML is used when there's no json.
+	directory = test.TempDir()
+
+	contents, err = os.ReadFile(filepath.Join(projectDirectory, PROJECT_YAML_FILE_NAME))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join(directory, PROJECT_YAML_FILE_NAME), contents, 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	project, err = LoadProject(directory)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if len(project.Targets) != 3 {
+		test.Errorf("Expected the YAML project in a directory without json to be loaded")
+	}
+}
+
+func TestParseProjectErrors(test *testing.T) {
+
+	var err error
+
+	invalid := map[string]string{
+		"unknown key":     `{"inputs": ["a"], "targets": [{"language": "go", "output": "gen"}], "extra": true}`,
+		"no inputs":       `{"targets": [{"language": "go", "output": "gen"}]}`,
+		"no targets":      `{"inputs": ["a"]}`,
+		"no output":       `{"inputs": ["a"], "targets": [{"language": "go"}]}`,
+		"unknown naming":  `{"inputs": ["a"], "targets": [{"language": "go", "output": "gen", "naming": "kebab"}]}`,
+		"misspelled yaml": "inputs: [a]\ntargets:\n  - language: go\n    outptu: gen\n",
+	}
+
+	for name, contents := range invalid {
+
+		if name == "misspelled yaml" {
+			_, err = ParseProjectYAML([]byte(contents))
+		} else {
+			_, err = ParseProject([]byte(contents))
+		}
+
+		if err == nil {
+			test.Errorf("Project with %s should fail to parse", name)
+		}
+	}
+}
+
+func TestProjectRun(test *testing.T) {
+
+	var project *Project
+	var output string
+	var err error
+
+	project, err = LoadProject(projectDirectory)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	output = test.TempDir()
+	for _, target := range project.Targets {
+		target.Output = filepath.Join(output, target.Output)
+	}
+
+	err = project.Run(context.Background())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	// the go target names its files in snake_case, while python already does.
+	for _, path := range []string{"gen/go/widget_part.go", "gen/py/widget_part.py", "gen/py/__init__.py", "gen/sql/widgets.sql"} {
+
+		_, err = os.Stat(filepath.Join(output, filepath.FromSlash(path)))
+		if err != nil {
+			test.Errorf("Expected '%s' to be generated: %s", path, err.Error())
+		}
+	}
+}
+
+func TestProjectRunNamingWithIndex(test *testing.T) {
+
+	var project *Project
+	var err error
+
+	project = &Project{
+		Inputs:  []string{"schemas"},
+		Root:    projectDirectory,
+		Targets: []*ProjectTarget{{Language: "py", Module: "widgets", Output: test.TempDir(), SplitFiles: true, Naming: NAMING_CAMEL, Tabstyle: "\t"}},
+	}
+
+	// python files import each other by name, so renaming them would break them.
+	err = project.Run(context.Background())
+	if _, isTargetErrors := err.(ProjectTargetErrors); !isTargetErrors {
+		test.Errorf("Renaming the files of a language with an index should fail its target, but got: %v", err)
+	}
+}
+
+func TestParseYAML(test *testing.T) {
+
+	var value, expected interface{}
+	var err error
+
+	value, err = parseYAML([]byte(`
+# a comment
+name: "quoted # not a comment"
+single: 'it''s'
+plain: some words # a comment
+number: -1.5e3
+empty:
+flags: [true, false, null]
+nested:
+  list:
+  - one
+  - {two: 2, three: "3"}
+  -
+    - deeper
+  mapping:
+    key: value
+`))
+
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = json.Unmarshal([]byte(`{
+		"name": "quoted # not a comment",
+		"single": "it's",
+		"plain": "some words",
+		"number": -1.5e3,
+		"empty": null,
+		"flags": [true, false, null],
+		"nested": {
+			"list": ["one", {"two": 2, "three": "3"}, ["deeper"]],
+			"mapping": {"key": "value"}
+		}
+	}`), &expected)
+
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(normalizeYAMLTestValue(value), expected) {
+		test.Errorf("YAML parsed to the wrong value: %#v", value)
+	}
+}
+
+func TestParseYAMLErrors(test *testing.T) {
+
+	var err error
+
+	invalid := map[string]string{
+		"bad indentation": "a:\n  b: 1\n    c: 2\n",
+		"duplicate key":   "a: 1\na: 2\n",
+		"anchor":          "a: &anchor 1\n",
+		"block scalar":    "a: |\n  text\n",
+		"unclosed flow":   "a: [1, 2\n",
+		"unclosed quote":  "a: \"text\n",
+		"two documents":   "a: 1\n---\nb: 2\n",
+		"tab indentation": "a:\n\tb: 1\n",
+	}
+
+	for name, contents := range invalid {
+
+		_, err = parseYAML([]byte(contents))
+		if err == nil {
+			test.Errorf("YAML with %s should fail to parse", name)
+		}
+	}
+}
+
+/*
+	Converts json.Numbers in the given parsed YAML [value] into float64s, the same as json.Unmarshal gives.
+*/
+func normalizeYAMLTestValue(value interface{}) interface{} {
+
+	switch typed := value.(type) {
+	case json.Number:
+		number, _ := typed.Float64()
+		return number
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = normalizeYAMLTestValue(item)
+		}
+	case map[string]interface{}:
+		for key, item := range typed {
+			typed[key] = normalizeYAMLTestValue(item)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,173 @@
+package presilo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+/*
+	Generated code contains "protected regions"; pairs of comments which mark where users may write their own code.
+	When a file is regenerated, the contents of every protected region in the existing file are carried over into
+	the region of the same name (its "anchor") in the new file. Everything else in the file is replaced.
+*/
+const (
+	PROTECTED_REGION_BEGIN = "presilo:begin"
+	PROTECTED_REGION_END   = "presilo:end"
+)
+
+var protectedRegionPattern = regexp.MustCompile(`presilo:(begin|end) (\S+)`)
+
+/*
+	Generates an empty protected region with the given [name], marked with the given line [comment] (such as "//" or "#").
+*/
+func generateProtectedRegion(name string, comment string, buffer *BufferedFormatString) {
+
+	buffer.Printf("\n%s %s %s", comment, PROTECTED_REGION_BEGIN, name)
+	buffer.Printf("\n%s %s %s", comment, PROTECTED_REGION_END, name)
+}
+
+/*
+	Returns the contents of every protected region in the given [code], by name.
+	Contents are returned exactly as written, including indentation, but not including the marker lines themselves.
+*/
+func ParseProtectedRegions(code string) (map[string]string, error) {
+
+	var ret map[string]string
+	var contents []string
+	var match []string
+	var name string
+	var found bool
+
+	ret = make(map[string]string)
+
+	for _, line := range strings.Split(code, "\n") {
+
+		match = protectedRegionPattern.FindStringSubmatch(line)
+
+		if match == nil {
+
+			if len(name) > 0 {
+				contents = append(contents, line)
+			}
+			continue
+		}
+
+		if match[1] == "begin" {
+
+			if len(name) > 0 {
+				errorMsg := fmt.Sprintf("Protected region '%s' begins inside protected region '%s'", match[2], name)
+				return nil, errors.New(errorMsg)
+			}
+
+			_, found = ret[match[2]]
+			if found {
+				errorMsg := fmt.Sprintf("Protected region '%s' is defined more than once", match[2])
+				return nil, errors.New(errorMsg)
+			}
+
+			name = match[2]
+			contents = nil
+			continue
+		}
+
+		if match[2] != name {
+			errorMsg := fmt.Sprintf("Protected region '%s' ends without beginning", match[2])
+			return nil, errors.New(errorMsg)
+		}
+
+		ret[name] = strings.Join(contents, "\n")
+		name = ""
+	}
+
+	if len(name) > 0 {
+		errorMsg := fmt.Sprintf("Protected region '%s' never ends", name)
+		return nil, errors.New(errorMsg)
+	}
+
+	return ret, nil
+}
+
+/*
+	Returns the given [code] without the contents of any of its protected regions (keeping the marker lines themselves).
+*/
+func stripProtectedRegions(code string) string {
+
+	var ret []string
+	var match []string
+	var inside bool
+
+	for _, line := range strings.Split(code, "\n") {
+
+		match = protectedRegionPattern.FindStringSubmatch(line)
+
+		if match != nil {
+			inside = match[1] == "begin"
+		} else if inside {
+			continue
+		}
+
+		ret = append(ret, line)
+	}
+
+	return strings.Join(ret, "\n")
+}
+
+/*
+	Returns the given [generated] code, with the contents of every protected region in [existing] written into the region of the same name.
+	Returns an error (and no code) if a protected region in [existing] has contents, but the generated code has no region of the same name to hold them.
+*/
+func MergeProtectedRegions(existing string, generated string) (string, error) {
+
+	var existingRegions, generatedRegions map[string]string
+	var lines, ret []string
+	var match []string
+	var err error
+	var found, replacing bool
+
+	existingRegions, err = ParseProtectedRegions(existing)
+	if err != nil {
+		return "", err
+	}
+
+	generatedRegions, err = ParseProtectedRegions(generated)
+	if err != nil {
+		return "", err
+	}
+
+	for name, contents := range existingRegions {
+
+		_, found = generatedRegions[name]
+		if !found && len(strings.TrimSpace(contents)) > 0 {
+			errorMsg := fmt.Sprintf("Protected region '%s' no longer has an anchor in generated code; move its contents before regenerating", name)
+			return "", errors.New(errorMsg)
+		}
+	}
+
+	lines = strings.Split(generated, "\n")
+
+	for _, line := range lines {
+
+		match = protectedRegionPattern.FindStringSubmatch(line)
+
+		if match != nil && match[1] == "begin" && len(existingRegions[match[2]]) > 0 {
+			ret = append(ret, line, existingRegions[match[2]])
+			replacing = true
+			continue
+		}
+
+		if match != nil && match[1] == "end" {
+			replacing = false
+		}
+
+		// whatever was generated inside a region is only a default, which existing contents replace.
+		if replacing {
+			continue
+		}
+
+		ret = append(ret, line)
+	}
+
+	return strings.Join(ret, "\n"), nil
+}
@@ -0,0 +1,73 @@
+package presilo
+
+import (
+	"testing"
+)
+
+func TestMergeProtectedRegions(test *testing.T) {
+
+	var existing, generated, expected, merged string
+	var err error
+
+	existing = "old code\n// presilo:begin Widget\n\tcustom()\n// presilo:end Widget\n// presilo:begin Empty\n// presilo:end Empty\n"
+	generated = "new code\n// presilo:begin Widget\n// presilo:end Widget\n// presilo:begin Empty\n\tdefault()\n// presilo:end Empty\n"
+
+	// existing contents replace what's generated, but an empty region keeps the generated default.
+	expected = "new code\n// presilo:begin Widget\n\tcustom()\n// presilo:end Widget\n// presilo:begin Empty\n\tdefault()\n// presilo:end Empty\n"
+
+	merged, err = MergeProtectedRegions(existing, generated)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if merged != expected {
+		test.Errorf("Protected regions weren't merged correctly.\nExpected:\n%s\nActual:\n%s", expected, merged)
+	}
+}
+
+func TestMergeProtectedRegionsWithoutAnchor(test *testing.T) {
+
+	var err error
+
+	// contents which would be lost are an error.
+	_, err = MergeProtectedRegions("// presilo:begin Gone\ncustom()\n// presilo:end Gone\n", "new code\n")
+	if err == nil {
+		test.Errorf("A region with contents, which has no anchor in the generated code, should be an error")
+	}
+
+	// but an empty region can go.
+	_, err = MergeProtectedRegions("// presilo:begin Gone\n\n// presilo:end Gone\n", "new code\n")
+	if err != nil {
+		test.Errorf("An empty region without an anchor should be dropped, but got: %s", err.Error())
+	}
+}
+
+func TestParseProtectedRegionsErrors(test *testing.T) {
+
+	var err error
+
+	invalid := map[string]string{
+		"nested":        "// presilo:begin A\n// presilo:begin B\n// presilo:end B\n// presilo:end A\n",
+		"duplicate":     "// presilo:begin A\n// presilo:end A\n// presilo:begin A\n// presilo:end A\n",
+		"never ending":  "// presilo:begin A\n",
+		"never started": "// presilo:end A\n",
+	}
+
+	for name, code := range invalid {
+
+		_, err = ParseProtectedRegions(code)
+		if err == nil {
+			test.Errorf("Protected regions which are %s should be an error", name)
+		}
+	}
+}
+
+func TestStripProtectedRegions(test *testing.T) {
+
+	var stripped string
+
+	stripped = stripProtectedRegions("code\n# presilo:begin A\ncustom\n# presilo:end A\nmore\n")
+	if stripped != "code\n# presilo:begin A\n# presilo:end A\nmore\n" {
+		test.Errorf("Expected only the contents of the region to be removed, but got:\n%s", stripped)
+	}
+}
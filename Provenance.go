@@ -0,0 +1,94 @@
+package presilo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+/*
+	The first line of every generated file's provenance header, in the form Go tools recognize as generated code.
+*/
+const PROVENANCE_MARKER = "Code generated by presilo. DO NOT EDIT."
+
+/*
+	Returns the header which begins a generated file containing the given [code], generated from the given [schemas].
+	Every line is a comment, begun with the given [comment].
+
+	The header names the source and ID of each schema, a hash of the (normalized) schemas,
+	and a hash of the code itself (not including anything later written into protected regions).
+	Sources beneath the given [sourceRoot] (if any) are named relative to it, so that headers don't depend on where the schemas were checked out.
+*/
+func generateProvenanceHeader(comment string, schemas []*ObjectSchema, sourceRoot string, code string) string {
+
+	var ret bytes.Buffer
+	var schemaHashes []string
+	var source, relativeSource string
+	var err error
+
+	ret.WriteString(fmt.Sprintf("%s %s\n", comment, PROVENANCE_MARKER))
+
+	for _, schema := range schemas {
+
+		source = schema.GetSourceFile()
+		if len(source) == 0 {
+			source = "(none)"
+		}
+
+		if len(sourceRoot) > 0 {
+
+			relativeSource, err = filepath.Rel(sourceRoot, source)
+			if err == nil && !strings.HasPrefix(relativeSource, "..") {
+				source = relativeSource
+			}
+		}
+
+		ret.WriteString(fmt.Sprintf("%s source: %s (id: %s)\n", comment, filepath.ToSlash(source), schema.GetID()))
+		schemaHashes = append(schemaHashes, schema.GetSchemaHash())
+	}
+
+	ret.WriteString(fmt.Sprintf("%s schema-hash: %s\n", comment, getHash(strings.Join(schemaHashes, "\n"))))
+	ret.WriteString(fmt.Sprintf("%s content-hash: %s\n\n", comment, getHash(stripProtectedRegions(code))))
+
+	return ret.String()
+}
+
+/*
+	Returns a hash of the given json [contents], after they're normalized;
+	all whitespace is removed, object keys are sorted, and numbers are kept exactly as written.
+*/
+func getNormalizedSchemaHash(contents []byte) (string, error) {
+
+	var decoder *json.Decoder
+	var value interface{}
+	var normalized []byte
+	var err error
+
+	decoder = json.NewDecoder(bytes.NewReader(contents))
+	decoder.UseNumber()
+
+	err = decoder.Decode(&value)
+	if err != nil {
+		return "", err
+	}
+
+	// maps are always marshalled with sorted keys.
+	normalized, err = json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return getHash(string(normalized)), nil
+}
+
+func getHash(contents string) string {
+
+	var sum [sha256.Size]byte
+
+	sum = sha256.Sum256([]byte(contents))
+	return hex.EncodeToString(sum[:])
+}
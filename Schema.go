@@ -10,6 +10,12 @@ type TypeSchema interface {
 	GetNullable() bool
 	SetNullable(bool)
 	HasConstraints() bool
+	IsReadOnly() bool
+	IsWriteOnly() bool
+	IsDeprecated() bool
+	GetNot() TypeSchema
+	GetExtensions() map[string]interface{}
+	GetSourceFile() string
 }
 
 /*
@@ -20,6 +26,12 @@ type Schema struct {
 	ID          string `json:"id"`
 	Description string `json:"description"`
 	Nullable    bool
+	ReadOnly    bool `json:"readOnly"`
+	WriteOnly   bool `json:"writeOnly"`
+	Deprecated  bool `json:"deprecated"`
+	Not         TypeSchema `json:"-"`
+	Extensions  map[string]interface{} `json:"-"`
+	SourceFile  string     `json:"-"`
 	typeCode    SchemaType
 }
 
@@ -54,3 +66,55 @@ func (this *Schema) GetNullable() bool {
 func (this *Schema) SetNullable(nullable bool) {
 	this.Nullable = nullable
 }
+
+/*
+  Returns true if this schema is managed by the owner of the data (e.g. a server-assigned ID),
+  and should not be set by consumers of generated code.
+*/
+func (this *Schema) IsReadOnly() bool {
+	return this.ReadOnly
+}
+
+/*
+  Returns true if this schema may be set by consumers, but should never be serialized back out (e.g. a password).
+*/
+func (this *Schema) IsWriteOnly() bool {
+	return this.WriteOnly
+}
+
+func (this *Schema) IsDeprecated() bool {
+	return this.Deprecated
+}
+
+/*
+  Returns the subschema which values of this schema must NOT match, or nil if there is none.
+*/
+func (this *Schema) GetNot() TypeSchema {
+	return this.Not
+}
+
+func (this *Schema) setNot(not TypeSchema) {
+	this.Not = not
+}
+
+/*
+  Returns every vendor extension keyword ("x-*") given to this schema, keyed by the full keyword.
+*/
+func (this *Schema) GetExtensions() map[string]interface{} {
+	return this.Extensions
+}
+
+func (this *Schema) setExtensions(extensions map[string]interface{}) {
+	this.Extensions = extensions
+}
+
+/*
+  Returns the path (or URL) of the file this schema was parsed from, or an empty string if it wasn't parsed from a file.
+*/
+func (this *Schema) GetSourceFile() string {
+	return this.SourceFile
+}
+
+func (this *Schema) setSourceFile(path string) {
+	this.SourceFile = path
+}
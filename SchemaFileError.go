@@ -0,0 +1,32 @@
+package presilo
+
+import (
+	"fmt"
+)
+
+/*
+	An error encountered while parsing one schema file.
+*/
+type SchemaFileError struct {
+	Path string
+	Err  error
+}
+
+func (this *SchemaFileError) Error() string {
+	return fmt.Sprintf("%s: %s", this.Path, this.Err.Error())
+}
+
+/*
+	Every error encountered while parsing a set of schema files, one per file which failed.
+*/
+type SchemaFileErrors []*SchemaFileError
+
+func (this SchemaFileErrors) Error() string {
+
+	var errs []error
+
+	for _, err := range this {
+		errs = append(errs, err)
+	}
+	return joinErrors(errs)
+}
@@ -53,6 +53,51 @@ func (this *SchemaGraph) GetOrderedSchemas() ([]*ObjectSchema, error) {
 	return ret, nil
 }
 
+/*
+  Returns the schemas in this graph which the given [schema] directly depends on, in the order its properties are declared.
+  A schema depends on the type of each object property, and of the items of each array property.
+*/
+func (this *SchemaGraph) GetDependencies(schema *ObjectSchema) []*ObjectSchema {
+
+	var ret []*ObjectSchema
+
+	for _, node := range this.nodes {
+
+		if node.schema != schema {
+			continue
+		}
+
+		for _, neighbor := range node.neighbors {
+
+			if !elementExistsInSlice(neighbor.schema, ret) {
+				ret = append(ret, neighbor.schema)
+			}
+		}
+		break
+	}
+
+	return ret
+}
+
+/*
+  Returns the schemas in this graph which directly depend on the given [schema]; the reverse of GetDependencies.
+*/
+func (this *SchemaGraph) GetDependents(schema *ObjectSchema) []*ObjectSchema {
+
+	var ret []*ObjectSchema
+
+	for _, node := range this.nodes {
+		for _, neighbor := range node.neighbors {
+
+			if neighbor.schema == schema && !elementExistsInSlice(node.schema, ret) {
+				ret = append(ret, node.schema)
+			}
+		}
+	}
+
+	return ret
+}
+
 func resolveDependency(node *SchemaGraphNode, resolution []*ObjectSchema) []*ObjectSchema {
 
 	// otherwise, descend deeper into the object.
@@ -96,9 +141,18 @@ func (this *SchemaGraphNode) discoverNeighbors(graph *SchemaGraph) {
 
 		schema = this.schema
 
-		for _, subschema = range schema.Properties {
+		// in declaration order, so that schemas are always ordered the same way.
+		for _, propertyName := range schema.GetOrderedPropertyNames() {
+
+			subschema = schema.Properties[propertyName]
+
+			// arrays depend on whatever they hold.
+			for subschema.GetSchemaType() == SCHEMATYPE_ARRAY && subschema.(*ArraySchema).Items != nil {
+				subschema = subschema.(*ArraySchema).Items
+			}
 
-			if subschema.GetSchemaType() == SCHEMATYPE_OBJECT {
+			// a schema which holds itself (such as a tree) is no dependency.
+			if subschema.GetSchemaType() == SCHEMATYPE_OBJECT && subschema != schema {
 				graph.addDependency(this, subschema.(*ObjectSchema))
 			}
 		}
@@ -1,10 +1,24 @@
 package presilo
 
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
 /*
   Contains parsing context, such as the currently-defined schemas by ID, and schema-local definitions.
 */
 type SchemaParseContext struct {
 	SchemaDefinitions map[string]TypeSchema
+
+	// The directory which all parsed files were found beneath, if they were parsed as a directory or glob.
+	// Together with each schema's source file, this lets generated output mirror the layout of its input.
+	SourceRoot string
+
+	// the file currently being parsed, given to every schema parsed from it.
+	sourceFile string
 }
 
 func NewSchemaParseContext() *SchemaParseContext {
@@ -15,3 +29,77 @@ func NewSchemaParseContext() *SchemaParseContext {
 	ret.SchemaDefinitions = make(map[string]TypeSchema)
 	return ret
 }
+
+/*
+	Adds every definition of the given [other] context to this one.
+	Unresolved references never replace an actual definition, so that references between files
+	can be linked once everything has been merged.
+	Returns an error (and adds nothing) if [other] defines a type, or a "#/definitions/" entry,
+	which this context already has from another file.
+*/
+func (this *SchemaParseContext) merge(other *SchemaParseContext) error {
+
+	var existing TypeSchema
+	var keys []string
+	var found bool
+
+	for key, _ := range other.SchemaDefinitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+
+		existing, found = this.SchemaDefinitions[key]
+
+		if found && isDuplicateDefinition(key, existing, other.SchemaDefinitions[key]) {
+			errorMsg := fmt.Sprintf("Schema '%s' is already defined in '%s'", key, existing.GetSourceFile())
+			return errors.New(errorMsg)
+		}
+	}
+
+	for _, key := range keys {
+
+		schema := other.SchemaDefinitions[key]
+		existing, found = this.SchemaDefinitions[key]
+
+		if found && schema.GetSchemaType() == SCHEMATYPE_UNRESOLVED && existing.GetSchemaType() != SCHEMATYPE_UNRESOLVED {
+			continue
+		}
+
+		this.SchemaDefinitions[key] = schema
+	}
+	return nil
+}
+
+/*
+	Returns true if [schema] would replace the different definition [existing] of the same [key].
+	Every subschema is kept by its ID (which defaults to its property name), so only types and "#/definitions/" entries,
+	which can be referenced or generated, are expected to be unique. The same file parsed twice defines the same thing.
+*/
+func isDuplicateDefinition(key string, existing TypeSchema, schema TypeSchema) bool {
+
+	if existing.GetSchemaType() == SCHEMATYPE_UNRESOLVED || schema.GetSchemaType() == SCHEMATYPE_UNRESOLVED {
+		return false
+	}
+
+	if existing.GetSourceFile() == schema.GetSourceFile() {
+		return false
+	}
+
+	return schema.GetSchemaType() == SCHEMATYPE_OBJECT || existing.GetSchemaType() == SCHEMATYPE_OBJECT || strings.HasPrefix(key, "#/definitions/")
+}
+
+/*
+	Orders the properties of every object schema in this context alphabetically, rather than in the order they were declared.
+	See ObjectSchema.SortProperties.
+*/
+func (this *SchemaParseContext) SortProperties() {
+
+	for _, schema := range this.SchemaDefinitions {
+
+		if schema.GetSchemaType() == SCHEMATYPE_OBJECT {
+			schema.(*ObjectSchema).SortProperties()
+		}
+	}
+}
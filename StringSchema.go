@@ -2,6 +2,8 @@ package presilo
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 )
 
 /*
@@ -9,12 +11,15 @@ import (
 */
 type StringSchema struct {
 	Schema
-	MaxLength     *int      `json:"maxLength"`
-	MinLength     *int      `json:"minLength"`
-	Pattern       *string   `json:"pattern"`
-	MaxByteLength *int      `json:"maxByteLength"`
-	MinByteLength *int      `json:"minByteLength"`
-	Enum          *[]string `json:"enum"`
+	MaxLength        *int      `json:"maxLength"`
+	MinLength        *int      `json:"minLength"`
+	Pattern          *string   `json:"pattern"`
+	MaxByteLength    *int      `json:"maxByteLength"`
+	MinByteLength    *int      `json:"minByteLength"`
+	Enum             *[]string `json:"enum"`
+	Const            *string   `json:"const"`
+	ContentEncoding  *string   `json:"contentEncoding"`
+	ContentMediaType *string   `json:"contentMediaType"`
 }
 
 func NewStringSchema() *StringSchema {
@@ -39,16 +44,75 @@ func ParseStringSchema(contents []byte, context *SchemaParseContext) (*StringSch
 		return ret, err
 	}
 
+	// a const is just an enum with one value.
+	if ret.Const != nil {
+		ret.Enum = &[]string{*ret.Const}
+	}
+
+	err = ret.checkBinary()
+	if err != nil {
+		return ret, err
+	}
+
 	return ret, nil
 }
 
+/*
+	Returns true if this schema describes binary content, encoded as a string only for transport.
+	Only base64 is supported, any other encoding is treated as a plain string.
+*/
+func (this *StringSchema) IsBinary() bool {
+	return this.ContentEncoding != nil && *this.ContentEncoding == "base64"
+}
+
+/*
+	Returns an error if this is a binary schema, but has constraints which only make sense for text.
+	Binary content is constrained by its byte length instead.
+*/
+func (this *StringSchema) checkBinary() error {
+
+	var unsupported []string
+
+	if !this.IsBinary() {
+		return nil
+	}
+
+	if this.Pattern != nil {
+		unsupported = append(unsupported, "pattern")
+	}
+	if this.Enum != nil {
+		unsupported = append(unsupported, "enum/const")
+	}
+	if this.MinLength != nil || this.MaxLength != nil {
+		unsupported = append(unsupported, "minLength/maxLength")
+	}
+
+	if len(unsupported) > 0 {
+		errorMsg := fmt.Sprintf("Binary (base64 contentEncoding) strings do not support %v, use minByteLength/maxByteLength instead", unsupported)
+		return errors.New(errorMsg)
+	}
+
+	return nil
+}
+
+/*
+	Makes this schema (used to constrain the value of the given [owner]) binary if its owner is, or text if it isn't.
+*/
+func (this *StringSchema) matchBinary(owner *StringSchema) error {
+
+	this.ContentEncoding = owner.ContentEncoding
+	this.ContentMediaType = owner.ContentMediaType
+	return this.checkBinary()
+}
+
 func (this *StringSchema) HasConstraints() bool {
 	return this.Enum != nil ||
 		this.MinLength != nil ||
 		this.MaxLength != nil ||
 		this.Pattern != nil ||
 		this.MaxByteLength != nil ||
-		this.MinByteLength != nil
+		this.MinByteLength != nil ||
+		this.Not != nil
 }
 
 func (this *StringSchema) HasEnum() bool {
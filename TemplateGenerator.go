@@ -0,0 +1,243 @@
+package presilo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"text/template"
+)
+
+/*
+	The name of the template which a TemplateGenerator renders for each object schema.
+	Every other template in the directory can be used from it with {{template "name.tmpl" .}}.
+*/
+const TEMPLATE_ENTRY = "type.tmpl"
+
+/*
+	A Generator which renders Go text/templates, loaded from a directory, against a TemplateType for each object schema.
+	Useful for small changes to generated code (an extra annotation, a different base class) which don't warrant a new generator.
+*/
+type TemplateGenerator struct {
+	BasicGenerator
+	templates *template.Template
+}
+
+/*
+	The view model given to templates, describing one object schema.
+*/
+type TemplateType struct {
+	Name        string
+	Description string
+	Module      string
+	Tabstyle    string
+	Deprecated  bool
+	Options     GeneratorOptions
+
+	// every property, in declaration order (see ObjectSchema.GetOrderedPropertyNames).
+	Properties []*TemplateProperty
+
+	// the properties a constructor should accept, in the order of "required".
+	ConstructorProperties []*TemplateProperty
+
+	Schema *ObjectSchema
+}
+
+/*
+	The view model given to templates for one property of an object schema.
+*/
+type TemplateProperty struct {
+
+	// the property name, exactly as it appears in the schema.
+	Name        string
+	Description string
+
+	// the json-schema type of the property; "string", "integer", "number", "boolean", "array", or "object".
+	Type string
+
+	Required    bool
+	Constrained bool
+	Nullable    bool
+	ReadOnly    bool
+	WriteOnly   bool
+	Deprecated  bool
+
+	// true if the property should have a getter and setter; it's constrained, or every field has them (see GeneratorOptions).
+	Accessors bool
+
+	// the property's validation rules (see GetModelRules).
+	Rules []*ModelRule
+
+	// for arrays, the property's items, named after the property. Nil otherwise.
+	Items *TemplateProperty
+
+	Schema TypeSchema
+}
+
+/*
+	Creates a new generator from every "*.tmpl" file in the given [directory], which must include TEMPLATE_ENTRY.
+	Generated files use the given [extension].
+*/
+func NewTemplateGenerator(directory string, extension string) (*TemplateGenerator, error) {
+
+	var ret *TemplateGenerator
+	var err error
+
+	ret = new(TemplateGenerator)
+	ret.Extension = extension
+	ret.GenerateFunc = ret.generate
+
+	ret.templates, err = template.New(TEMPLATE_ENTRY).Funcs(getTemplateFuncs()).ParseGlob(filepath.Join(directory, "*.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+
+	if ret.templates.Lookup(TEMPLATE_ENTRY) == nil {
+		errorMsg := fmt.Sprintf("No '%s' template was found in '%s'", TEMPLATE_ENTRY, directory)
+		return nil, errors.New(errorMsg)
+	}
+
+	return ret, nil
+}
+
+/*
+	Renders the templates of this generator for the given [schema].
+*/
+func (this *TemplateGenerator) Render(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) (string, error) {
+
+	var buffer bytes.Buffer
+	var err error
+
+	err = this.templates.ExecuteTemplate(&buffer, TEMPLATE_ENTRY, NewTemplateType(schema, module, tabstyle, options))
+	return buffer.String(), err
+}
+
+/*
+	Renders the templates of this generator for the given [schema], returning any error rendering them.
+	This is what the output pipeline uses (see FallibleGenerator), so that a template which fails to render fails generation.
+*/
+func (this *TemplateGenerator) TryGenerate(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) (string, error) {
+	return this.Render(schema, module, tabstyle, options)
+}
+
+/*
+	Generate can't return an error, so whatever was rendered before a failure is all that's returned.
+	Use TryGenerate (or Render) to find out whether rendering failed.
+*/
+func (this *TemplateGenerator) generate(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
+
+	var ret string
+
+	ret, _ = this.Render(schema, module, tabstyle, options)
+	return ret
+}
+
+/*
+	Creates the view model which templates are rendered against for the given [schema].
+*/
+func NewTemplateType(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) *TemplateType {
+
+	var ret *TemplateType
+	var model *ModelType
+	var properties map[*ModelField]*TemplateProperty
+	var property *TemplateProperty
+	var accessors []*ModelField
+
+	model = NewModelType(schema)
+
+	ret = new(TemplateType)
+	ret.Name = model.Name
+	ret.Description = model.Description
+	ret.Module = module
+	ret.Tabstyle = tabstyle
+	ret.Deprecated = schema.IsDeprecated()
+	ret.Options = options
+	ret.Schema = schema
+
+	properties = make(map[*ModelField]*TemplateProperty)
+	accessors = getAccessorFields(model, options)
+
+	for _, field := range model.Fields {
+
+		property = newTemplateProperty(field)
+
+		for _, accessor := range accessors {
+			if accessor == field {
+				property.Accessors = true
+			}
+		}
+
+		properties[field] = property
+		ret.Properties = append(ret.Properties, property)
+	}
+
+	for _, field := range model.ConstructorFields {
+		ret.ConstructorProperties = append(ret.ConstructorProperties, properties[field])
+	}
+
+	return ret
+}
+
+func newTemplateProperty(field *ModelField) *TemplateProperty {
+
+	var ret *TemplateProperty
+	var schema TypeSchema
+
+	schema = field.Schema
+
+	ret = new(TemplateProperty)
+	ret.Name = field.Name
+	ret.Required = field.Required
+	ret.Description = schema.GetDescription()
+	ret.Type = getSchemaTypeName(schema.GetSchemaType())
+	ret.Constrained = schema.HasConstraints()
+	ret.Nullable = schema.GetNullable()
+	ret.ReadOnly = schema.IsReadOnly()
+	ret.WriteOnly = schema.IsWriteOnly()
+	ret.Deprecated = schema.IsDeprecated()
+	ret.Rules = field.Rules
+	ret.Schema = schema
+
+	if field.Items != nil {
+		ret.Items = newTemplateProperty(field.Items)
+	}
+
+	return ret
+}
+
+/*
+	Returns the json-schema type name of the given [schemaType].
+*/
+func getSchemaTypeName(schemaType SchemaType) string {
+
+	switch schemaType {
+	case SCHEMATYPE_OBJECT:
+		return "object"
+	case SCHEMATYPE_ARRAY:
+		return "array"
+	}
+
+	return getScalarSchemaTypeName(schemaType)
+}
+
+/*
+	Returns the functions available to every template;
+	the casing functions of this package, and the types the Go, Java, and C# generators use for a schema.
+	Python, Ruby, and JavaScript are dynamically typed, and MySQL columns don't map one-to-one to properties,
+	so they have no equivalent; templates for them can use a property's Type instead.
+*/
+func getTemplateFuncs() template.FuncMap {
+
+	return template.FuncMap{
+		"ToCamelCase":       ToCamelCase,
+		"ToJavaCase":        ToJavaCase,
+		"ToSnakeCase":       ToSnakeCase,
+		"ToStrictCamelCase": ToStrictCamelCase,
+		"ToStrictJavaCase":  ToStrictJavaCase,
+		"GoType": func(schema TypeSchema) string {
+			return GenerateGoTypeForSchema(schema)
+		},
+		"JavaType":   GenerateJavaTypeForSchema,
+		"CSharpType": GenerateCSharpTypeForSchema,
+	}
+}
@@ -0,0 +1,114 @@
+package presilo
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+/*
+	Templates for the tests below live in "<name>" directories here, and what they render in "golden/<schema>".
+*/
+const templateDirectory = "testdata/templates"
+
+/*
+	Renders the "objects" conformance schema (with a nested type, a reference, and readOnly, writeOnly and deprecated properties)
+	with the "record" templates, which use every template function, and compares each file to its golden file.
+*/
+func TestTemplateGenerator(test *testing.T) {
+
+	var generator *TemplateGenerator
+	var context *SchemaParseContext
+	var files map[string]string
+	var goldenPath string
+	var err error
+
+	generator, err = NewTemplateGenerator(filepath.Join(templateDirectory, "record"), "txt")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	registerTestGenerator(test, "record", generator)
+
+	context, err = parseConformanceSchema(filepath.Join(conformanceDirectory, "schemas", "objects.json"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	files, err = GenerateCode(context, "conformance", "record", "\t", false, true, GeneratorOptions{})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	goldenPath = filepath.Join(templateDirectory, "golden", "objects")
+
+	if *updateGoldens {
+
+		err = writeGoldenFiles(goldenPath, files)
+		if err != nil {
+			test.Fatal(err)
+		}
+		return
+	}
+
+	compareGoldenFiles(test, goldenPath, files)
+}
+
+func TestNewTemplateGeneratorErrors(test *testing.T) {
+
+	var err error
+
+	invalid := map[string]string{
+		"no templates":       "missing",
+		"no type.tmpl":       "noentry",
+		"unparseable syntax": "unparseable",
+	}
+
+	for name, directory := range invalid {
+
+		_, err = NewTemplateGenerator(filepath.Join(templateDirectory, directory), "txt")
+		if err == nil {
+			test.Errorf("Templates with %s should fail to load", name)
+		}
+	}
+}
+
+/*
+	A template which fails to render must fail generation with a GenerationError for its schema, rather than writing what it rendered.
+*/
+func TestTemplateRenderError(test *testing.T) {
+
+	var generator *TemplateGenerator
+	var context *SchemaParseContext
+	var files map[string]string
+	var errs GenerationErrors
+	var isGenerationErrors bool
+	var err error
+
+	generator, err = NewTemplateGenerator(filepath.Join(templateDirectory, "broken"), "txt")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	_, err = generator.TryGenerate(NewObjectSchema(), "conformance", "\t", GeneratorOptions{})
+	if err == nil {
+		test.Errorf("Expected TryGenerate to return the error rendering the template")
+	}
+
+	registerTestGenerator(test, "broken", generator)
+
+	context, err = parseConformanceSchema(filepath.Join(conformanceDirectory, "schemas", "strings.json"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	files, err = GenerateCode(context, "conformance", "broken", "\t", false, true, GeneratorOptions{})
+
+	errs, isGenerationErrors = err.(GenerationErrors)
+	if !isGenerationErrors || len(errs) != 1 || errs[0].Schema != "Strings" {
+		test.Fatalf("Expected one GenerationError for 'Strings', but got: %v", err)
+	}
+
+	if len(files) > 0 {
+		test.Errorf("Expected nothing to be generated, but got %d files", len(files))
+	}
+}
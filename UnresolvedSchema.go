@@ -55,3 +55,33 @@ func (this *UnresolvedSchema) SetNullable(bool) {
 func (this *UnresolvedSchema) HasConstraints() bool {
 	return false
 }
+
+// Used to satisfy the TypeSchema contract, stub.
+func (this *UnresolvedSchema) IsReadOnly() bool {
+	return false
+}
+
+// Used to satisfy the TypeSchema contract, stub.
+func (this *UnresolvedSchema) IsWriteOnly() bool {
+	return false
+}
+
+// Used to satisfy the TypeSchema contract, stub.
+func (this *UnresolvedSchema) IsDeprecated() bool {
+	return false
+}
+
+// Used to satisfy the TypeSchema contract, stub.
+func (this *UnresolvedSchema) GetNot() TypeSchema {
+	return nil
+}
+
+// Used to satisfy the TypeSchema contract, stub.
+func (this *UnresolvedSchema) GetExtensions() map[string]interface{} {
+	return nil
+}
+
+// Used to satisfy the TypeSchema contract, stub.
+func (this *UnresolvedSchema) GetSourceFile() string {
+	return ""
+}
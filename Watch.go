@@ -0,0 +1,320 @@
+package presilo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// how often a Watcher checks its files for changes, unless told otherwise.
+	WATCH_DEFAULT_INTERVAL = 250 * time.Millisecond
+
+	// how long a Watcher waits for files to stop changing before it regenerates code, unless told otherwise.
+	WATCH_DEFAULT_DEBOUNCE = 500 * time.Millisecond
+)
+
+/*
+	Describes one regeneration by a Watcher.
+*/
+type WatchEvent struct {
+
+	// the IDs of the schemas whose files were regenerated, sorted.
+	Regenerated []string
+
+	// the IDs of the schemas which no longer exist, sorted. Their files are left where they are (see CheckGeneratedCode).
+	Removed []string
+
+	// nil if everything was regenerated. If the schemas couldn't be parsed, this is the parse error, and nothing was generated.
+	// Otherwise it's a ProjectTargetErrors, with one entry per target which failed.
+	Err error
+}
+
+/*
+	Regenerates the code of a project whenever its schemas change.
+	Files are polled rather than watched by the OS, so that watching works the same everywhere.
+*/
+type Watcher struct {
+	Project *Project
+
+	// how often files are checked for changes.
+	Interval time.Duration
+
+	// how long files must go unchanged, once a change is seen, before code is regenerated.
+	// Saving several files at once (or an editor which writes a file in steps) only regenerates once.
+	Debounce time.Duration
+
+	// called after every regeneration, including those which failed. May be nil.
+	Report func(*WatchEvent)
+
+	// the last seen state of every watched file, by path.
+	files map[string]watchedFile
+
+	// every local file which a schema was last parsed from, which includes every file an input refers to.
+	sources []string
+
+	// a fingerprint of every object schema by ID, as of the last regeneration which succeeded.
+	fingerprints map[string]string
+}
+
+type watchedFile struct {
+	modified time.Time
+	size     int64
+}
+
+/*
+	Creates a new watcher for the given [project], with the default interval and debounce.
+*/
+func NewWatcher(project *Project) *Watcher {
+
+	var ret *Watcher
+
+	ret = new(Watcher)
+	ret.Project = project
+	ret.Interval = WATCH_DEFAULT_INTERVAL
+	ret.Debounce = WATCH_DEFAULT_DEBOUNCE
+	return ret
+}
+
+/*
+	Loads the project at the given [path] (see LoadProject), and watches it (see Watcher.Run) until [ctx] is done.
+	Every regeneration is given to [report], which may be nil.
+*/
+func WatchProjectFile(ctx context.Context, path string, report func(*WatchEvent)) error {
+
+	var watcher *Watcher
+	var project *Project
+	var err error
+
+	project, err = LoadProject(path)
+	if err != nil {
+		return err
+	}
+
+	watcher = NewWatcher(project)
+	watcher.Report = report
+	return watcher.Run(ctx)
+}
+
+/*
+	Generates the project's code, then regenerates it whenever the project's inputs change, until [ctx] is done.
+	Always returns the context's error.
+
+	Watched files are the project's inputs (globs and directories are expanded again on every check, so new files are noticed)
+	and the file of every schema they refer to. The project file itself isn't watched.
+	Only the types whose schemas changed, and the types which depend on them, are regenerated; see WatchEvent.
+	Errors (including schemas which can't be parsed) are reported, and watching carries on.
+*/
+func (this *Watcher) Run(ctx context.Context) error {
+
+	var ticker *time.Ticker
+	var files map[string]watchedFile
+	var lastChange time.Time
+	var pending bool
+
+	this.files = this.getWatchedFiles()
+	this.regenerate(ctx)
+
+	ticker = time.NewTicker(this.Interval)
+	defer ticker.Stop()
+
+	for {
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		files = this.getWatchedFiles()
+
+		if !watchedFilesEqual(files, this.files) {
+			this.files = files
+			lastChange = time.Now()
+			pending = true
+			continue
+		}
+
+		if pending && time.Since(lastChange) >= this.Debounce {
+			pending = false
+			this.regenerate(ctx)
+		}
+	}
+}
+
+/*
+	Parses the project, and regenerates the files of every schema which changed since the last regeneration that succeeded.
+*/
+func (this *Watcher) regenerate(ctx context.Context) {
+
+	var context *SchemaParseContext
+	var event *WatchEvent
+	var schemas []*ObjectSchema
+	var fingerprints map[string]string
+	var only map[string]bool
+	var found bool
+	var err error
+
+	context, err = this.Project.Parse()
+	if err != nil {
+		this.report(&WatchEvent{Err: err})
+		return
+	}
+
+	this.sources = nil
+	fingerprints = make(map[string]string)
+
+	for _, id := range getSortedSchemaIDs(context) {
+
+		schema := context.SchemaDefinitions[id]
+		source := schema.GetSourceFile()
+
+		// remote schemas can't be polled.
+		if len(source) > 0 && !strings.Contains(source, "://") && !arrayContainsString(this.sources, source) {
+			this.sources = append(this.sources, source)
+		}
+
+		if schema.GetSchemaType() == SCHEMATYPE_OBJECT {
+			schemas = append(schemas, schema.(*ObjectSchema))
+			fingerprints[id] = getSchemaFingerprint(schema.(*ObjectSchema))
+		}
+	}
+
+	event = new(WatchEvent)
+	only = this.getChangedSchemas(schemas, fingerprints)
+
+	for id, _ := range only {
+		event.Regenerated = append(event.Regenerated, id)
+	}
+
+	for id, _ := range this.fingerprints {
+
+		_, found = fingerprints[id]
+		if !found {
+			event.Removed = append(event.Removed, id)
+		}
+	}
+
+	// a change which makes no difference to any schema (such as whitespace) regenerates nothing.
+	if len(event.Regenerated) == 0 && len(event.Removed) == 0 {
+		return
+	}
+
+	sort.Strings(event.Regenerated)
+	sort.Strings(event.Removed)
+
+	event.Err = this.Project.generate(ctx, context, only)
+
+	// if anything failed, the same schemas count as changed until a regeneration succeeds.
+	if event.Err == nil {
+		this.fingerprints = fingerprints
+	}
+
+	this.report(event)
+}
+
+/*
+	Returns the IDs of the given [schemas] whose [fingerprints] differ from the last regeneration,
+	along with every schema which depends on them, directly or not.
+*/
+func (this *Watcher) getChangedSchemas(schemas []*ObjectSchema, fingerprints map[string]string) map[string]bool {
+
+	var ret map[string]bool
+	var graph *SchemaGraph
+	var changed []*ObjectSchema
+	var schema *ObjectSchema
+
+	ret = make(map[string]bool)
+	graph = NewSchemaGraph(schemas)
+
+	for _, schema = range schemas {
+
+		if this.fingerprints[schema.GetID()] != fingerprints[schema.GetID()] {
+			changed = append(changed, schema)
+		}
+	}
+
+	// a type which holds a changed type may need to change too (if the type was renamed, say).
+	for len(changed) > 0 {
+
+		schema = changed[0]
+		changed = changed[1:]
+
+		if ret[schema.GetID()] {
+			continue
+		}
+
+		ret[schema.GetID()] = true
+		changed = append(changed, graph.GetDependents(schema)...)
+	}
+
+	return ret
+}
+
+/*
+	Returns the state of every file this watcher watches.
+	Files which don't exist are left out, so that a missing file counts as a change.
+*/
+func (this *Watcher) getWatchedFiles() map[string]watchedFile {
+
+	var ret map[string]watchedFile
+	var paths []string
+	var info os.FileInfo
+	var err error
+
+	ret = make(map[string]watchedFile)
+
+	// an input which can't be found is reported when the project is parsed.
+	paths, _ = this.Project.getInputPaths()
+	paths = append(paths, this.sources...)
+
+	for _, path := range paths {
+
+		info, err = os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		ret[path] = watchedFile{modified: info.ModTime(), size: info.Size()}
+	}
+
+	return ret
+}
+
+func (this *Watcher) report(event *WatchEvent) {
+
+	if this.Report != nil {
+		this.Report(event)
+	}
+}
+
+/*
+	Returns a fingerprint of everything about the given [schema] which affects its generated code;
+	its contents, its title (which may come from its file name), and where it came from (which is named in its provenance header).
+*/
+func getSchemaFingerprint(schema *ObjectSchema) string {
+	return fmt.Sprintf("%s\n%s\n%s", schema.GetSchemaHash(), schema.GetTitle(), schema.GetSourceFile())
+}
+
+func watchedFilesEqual(a map[string]watchedFile, b map[string]watchedFile) bool {
+
+	var other watchedFile
+	var found bool
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for path, file := range a {
+
+		other, found = b[path]
+		if !found || !other.modified.Equal(file.modified) || other.size != file.size {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+package presilo
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWatcherRegeneratesChangedSchemas(test *testing.T) {
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var watcher *Watcher
+	var events chan *WatchEvent
+	var done chan error
+	var event *WatchEvent
+	var directory, schemaPath string
+	var err error
+
+	directory = test.TempDir()
+	schemaPath = filepath.Join(directory, "widget.json")
+
+	writeWatchedSchema(test, schemaPath, `{"title": "Widget", "type": "object", "properties": {"name": {"type": "string"}}}`)
+	writeWatchedSchema(test, filepath.Join(directory, "gadget.json"), `{"title": "Gadget", "type": "object", "properties": {"size": {"type": "integer"}}}`)
+
+	events = make(chan *WatchEvent, 10)
+	done = make(chan error, 1)
+
+	watcher = NewWatcher(&Project{
+		Inputs:  []string{"*.json"},
+		Root:    directory,
+		Targets: []*ProjectTarget{{Language: "go", Module: "widgets", Output: "gen", SplitFiles: true, Tabstyle: "\t"}},
+	})
+	watcher.Interval = 5 * time.Millisecond
+	watcher.Debounce = 20 * time.Millisecond
+	watcher.Report = func(event *WatchEvent) {
+		events <- event
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	// everything is generated to begin with.
+	event = waitForWatchEvent(test, events)
+	if event.Err != nil || !reflect.DeepEqual(event.Regenerated, []string{"Gadget", "Widget"}) {
+		test.Fatalf("Expected every schema to be generated first, but got %+v", event)
+	}
+
+	// then only the schema which changed.
+	writeWatchedSchema(test, schemaPath, `{"title": "Widget", "type": "object", "properties": {"name": {"type": "string"}, "count": {"type": "integer"}}}`)
+
+	event = waitForWatchEvent(test, events)
+	if event.Err != nil || !reflect.DeepEqual(event.Regenerated, []string{"Widget"}) {
+		test.Fatalf("Expected only the changed schema to be regenerated, but got %+v", event)
+	}
+
+	// and removed schemas are reported, without regenerating anything else.
+	err = os.Remove(schemaPath)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	event = waitForWatchEvent(test, events)
+	if event.Err != nil || len(event.Regenerated) != 0 || !reflect.DeepEqual(event.Removed, []string{"Widget"}) {
+		test.Fatalf("Expected the removed schema to be reported, but got %+v", event)
+	}
+
+	cancel()
+
+	err = <-done
+	if err != context.Canceled {
+		test.Errorf("Run should return the context's error once it's done, but returned %v", err)
+	}
+}
+
+func waitForWatchEvent(test *testing.T, events chan *WatchEvent) *WatchEvent {
+
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		test.Fatal("Timed out waiting for the watcher to regenerate")
+	}
+	return nil
+}
+
+func writeWatchedSchema(test *testing.T, path string, contents string) {
+
+	var err error
+
+	err = os.WriteFile(path, []byte(contents), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+}
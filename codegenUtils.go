@@ -1,6 +1,8 @@
 package presilo
 
 import (
+	"fmt"
+	"math/big"
 	"strings"
 )
 
@@ -11,7 +13,7 @@ func containsRegexpMatch(schema *ObjectSchema) bool {
 
 	var schemaType SchemaType
 
-	for _, property := range schema.Properties {
+	for _, property := range getAllConstrainedSchemas(schema) {
 
 		schemaType = property.GetSchemaType()
 
@@ -23,6 +25,21 @@ func containsRegexpMatch(schema *ObjectSchema) bool {
 	return false
 }
 
+/*
+	Returns true if any array used by the given schema (including array items) must have unique items.
+*/
+func containsUniqueItems(schema *ObjectSchema) bool {
+
+	for _, property := range getAllConstrainedSchemas(schema) {
+
+		if len(filterModelRules(GetModelRules(property), MODELRULE_UNIQUE, MODELSUBJECT_ITEMS)) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 /*
 	Returns true if any string property of the given schema contains a pattern match
 */
@@ -30,11 +47,11 @@ func containsNumberMod(schema *ObjectSchema) bool {
 
 	var schemaType SchemaType
 
-	for _, property := range schema.Properties {
+	for _, property := range getAllConstrainedSchemas(schema) {
 
 		schemaType = property.GetSchemaType()
 
-		if schemaType == SCHEMATYPE_NUMBER && property.(*NumberSchema).MultipleOf != nil {
+		if schemaType == SCHEMATYPE_NUMBER && property.(*NumberSchema).MultipleOf != nil && !property.(*NumberSchema).IsDecimal() {
 			return true
 		}
 	}
@@ -50,6 +67,122 @@ func sanitizeQuotedString(target string) string {
 	return strings.Replace(target, "\"", "\\\"", -1)
 }
 
+/*
+	Returns the code which reports that a value violated the given [keyword] (such as "minLength"), described by [description].
+	[statement] is how a setter reports it, by returning (or throwing) the first violation found;
+	validation methods instead record every violation, and carry on.
+*/
+type checkFailure func(keyword string, description string, statement string) string
+
+/*
+	The checkFailure of setters, which stop at the first violation.
+*/
+func failWithStatement(keyword string, description string, statement string) string {
+	return statement
+}
+
+/*
+	Returns the text which describes a violation of the given [keyword] between its path and its value,
+	escaped to go between double quotes (in any of the languages whose strings escape with backslashes).
+*/
+func getViolationText(keyword string, description string) string {
+	return getWriteOnlyViolationText(keyword, description) + ", value: "
+}
+
+/*
+	Returns the text which describes a violation of the given [keyword] after its path, without its value;
+	escaped the same as getViolationText.
+*/
+func getWriteOnlyViolationText(keyword string, description string) string {
+
+	var escaper *strings.Replacer
+
+	escaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"")
+	return escaper.Replace(fmt.Sprintf(": %s: %s", keyword, description))
+}
+
+/*
+	Returns the text which describes [dependency] missing while [propertyName] is present (a dependentRequired violation),
+	after the path of the object that holds them; escaped the same as getViolationText.
+*/
+func getDependentRequiredViolationText(dependency string, propertyName string) string {
+	return sanitizeQuotedString(dependency) + getWriteOnlyViolationText("dependentRequired", fmt.Sprintf("is required when '%s' is present", propertyName))
+}
+
+/*
+	Returns the checkFailure which reports violations of the given [subschema]; [writeOnlyViolation] if it's writeOnly
+	(whose values are secrets like passwords, and shouldn't end up in messages which may be logged), or [violation] otherwise.
+*/
+func chooseViolation(subschema TypeSchema, violation checkFailure, writeOnlyViolation checkFailure) checkFailure {
+
+	if subschema.IsWriteOnly() {
+		return writeOnlyViolation
+	}
+	return violation
+}
+
+/*
+	Returns true if a setter for the given [subschema] should refuse null, in languages where any value can be null;
+	strings, objects and arrays, unless they're nullable.
+*/
+func isNullRefused(subschema TypeSchema) bool {
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING, SCHEMATYPE_OBJECT, SCHEMATYPE_ARRAY:
+		return !subschema.GetNullable()
+	}
+	return false
+}
+
+/*
+	Returns true if a validation method has anything to check for the given [schema] in the given [language];
+	constraints of its own, objects (which validate themselves), or arrays of either.
+	Overridden types are never checked.
+*/
+func needsValidation(schema TypeSchema, language string) bool {
+
+	if hasTypeOverride(schema, language) {
+		return false
+	}
+
+	switch schema.GetSchemaType() {
+	case SCHEMATYPE_OBJECT:
+		return true
+	case SCHEMATYPE_ARRAY:
+		return len(GetModelRules(schema)) > 0 || needsValidation(schema.(*ArraySchema).Items, language)
+	}
+
+	return schema.HasConstraints()
+}
+
+/*
+	Returns true if the given [field] is optional, and held in a numeric primitive (one without a [presence] check),
+	which always has a value; so its zero value is the only sign it was never set.
+*/
+func isOptionalPrimitive(field *ModelField, presence string) bool {
+
+	var schemaType SchemaType
+
+	schemaType = field.Schema.GetSchemaType()
+	return len(presence) == 0 && !field.Required && (schemaType == SCHEMATYPE_INTEGER || schemaType == SCHEMATYPE_NUMBER)
+}
+
+/*
+	Returns the fields of the given [model] which its validation method checks in the given [language], in declaration order.
+*/
+func getValidatedFields(model *ModelType, language string) []*ModelField {
+
+	var ret []*ModelField
+
+	for _, field := range model.Fields {
+
+		if needsValidation(field.Schema, language) {
+			ret = append(ret, field)
+		}
+	}
+	return ret
+}
+
 /*
 	Returns true if the given [source] array contains the given [candidate].
 	False otherwise.
@@ -63,3 +196,258 @@ func arrayContainsString(source []string, candidate string) bool {
 	}
 	return false
 }
+
+/*
+	Returns true if the given schema, or any of its properties, is deprecated.
+*/
+func containsDeprecation(schema *ObjectSchema) bool {
+
+	if schema.IsDeprecated() {
+		return true
+	}
+
+	for _, property := range schema.Properties {
+		if property.IsDeprecated() {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+	Returns all conditional schemas (if/then/else and dependent schemas) of the given schema,
+	in a stable order.
+*/
+func getConditionalSchemas(schema *ObjectSchema) []*ConditionalSchema {
+
+	var ret []*ConditionalSchema
+
+	// an "if" without a "then" or "else" has no effect.
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		for _, condition := range []*ConditionalSchema{schema.If, schema.Then, schema.Else} {
+			if condition != nil {
+				ret = append(ret, condition)
+			}
+		}
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		if condition, found := schema.DependentSchemas[propertyName]; found {
+			ret = append(ret, condition)
+		}
+	}
+
+	return ret
+}
+
+/*
+	Returns every subschema whose constraints may end up in code generated for the given schema;
+	its properties (and their array items, which are checked by validation), the properties of its conditions, and any "not" subschemas of those.
+*/
+func getAllConstrainedSchemas(schema *ObjectSchema) []TypeSchema {
+
+	var ret []TypeSchema
+
+	for _, property := range schema.Properties {
+
+		ret = appendWithNotSchemas(ret, property)
+
+		for property.GetSchemaType() == SCHEMATYPE_ARRAY {
+			property = property.(*ArraySchema).Items
+			ret = appendWithNotSchemas(ret, property)
+		}
+	}
+
+	for _, condition := range getConditionalSchemas(schema) {
+		for _, property := range condition.Properties {
+			ret = appendWithNotSchemas(ret, property)
+		}
+	}
+
+	return ret
+}
+
+/*
+	Returns the number of "not" subschemas nested beneath the given [schema].
+	Useful for generating names which won't collide with those used by nested checks.
+*/
+func getNotDepth(schema TypeSchema) int {
+
+	var depth int
+
+	for schema = schema.GetNot(); schema != nil; schema = schema.GetNot() {
+		depth++
+	}
+	return depth
+}
+
+/*
+	Prints a warning for every property of the given [schema] with a "not" constraint
+	which the generator for the given [language] (named [languageName]) can't check.
+	Properties whose type is overridden get none of their checks generated, so their "not" constraints are skipped too.
+*/
+func warnUncheckedNots(schema *ObjectSchema, language string, languageName string) {
+
+	var subschema TypeSchema
+
+	for _, propertyName := range schema.GetOrderedPropertyNames() {
+
+		for subschema = schema.Properties[propertyName]; subschema != nil; subschema = getArrayItems(subschema) {
+
+			if subschema.GetNot() != nil && hasTypeOverride(subschema, language) {
+				fmt.Printf("Property '%s' of '%s' contains a 'not' constraint, which can't be checked in %s since its type is overridden.\n", propertyName, schema.GetTitle(), languageName)
+			}
+		}
+	}
+}
+
+/*
+	Returns the items of the given [schema] if it's an array, or nil otherwise.
+*/
+func getArrayItems(schema TypeSchema) TypeSchema {
+
+	if schema.GetSchemaType() != SCHEMATYPE_ARRAY {
+		return nil
+	}
+	return schema.(*ArraySchema).Items
+}
+
+func appendWithNotSchemas(schemas []TypeSchema, schema TypeSchema) []TypeSchema {
+
+	for schema != nil {
+		schemas = append(schemas, schema)
+		schema = schema.GetNot()
+	}
+	return schemas
+}
+
+/*
+	Returns the "const" value of the given schema, and true if it has one.
+	If the schema has no const, returns nil and false.
+*/
+func getConstValue(schema TypeSchema) (interface{}, bool) {
+
+	switch schema.(type) {
+	case *StringSchema:
+		if schema.(*StringSchema).Const != nil {
+			return *schema.(*StringSchema).Const, true
+		}
+	case *IntegerSchema:
+		if schema.(*IntegerSchema).Const != nil {
+			return schema.(*IntegerSchema).Const, true
+		}
+	case *NumberSchema:
+		if schema.(*NumberSchema).Const != nil {
+
+			if schema.(*NumberSchema).IsDecimal() {
+				return *schema.(*NumberSchema).decimals.Const, true
+			}
+			return *schema.(*NumberSchema).Const, true
+		}
+	case *BooleanSchema:
+		if schema.(*BooleanSchema).Const != nil {
+			return *schema.(*BooleanSchema).Const, true
+		}
+	}
+
+	return nil, false
+}
+
+/*
+	Returns the given const [value] as a literal, quoting strings and
+	spelling booleans with the given [trueLiteral] and [falseLiteral].
+*/
+func getConstLiteral(value interface{}, trueLiteral string, falseLiteral string) string {
+
+	switch value.(type) {
+	case string:
+		return fmt.Sprintf("\"%s\"", sanitizeQuotedString(value.(string)))
+	case bool:
+		if value.(bool) {
+			return trueLiteral
+		}
+		return falseLiteral
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+/*
+	Returns true if any integer used by the given schema (including array items) needs arbitrary precision.
+*/
+func containsBigInteger(schema *ObjectSchema) bool {
+
+	for _, property := range getAllConstrainedSchemas(schema) {
+
+		for property.GetSchemaType() == SCHEMATYPE_ARRAY {
+			property = property.(*ArraySchema).Items
+		}
+
+		if property.GetSchemaType() == SCHEMATYPE_INTEGER && property.(*IntegerSchema).IsBig() {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+	Returns true if the given schema is a number schema which represents exact decimals.
+*/
+func isDecimal(schema TypeSchema) bool {
+	return schema.GetSchemaType() == SCHEMATYPE_NUMBER && schema.(*NumberSchema).IsDecimal()
+}
+
+/*
+	Returns true if any number used by the given schema (including array items) is an exact decimal.
+*/
+func containsDecimal(schema *ObjectSchema) bool {
+
+	for _, property := range getAllConstrainedSchemas(schema) {
+
+		for property.GetSchemaType() == SCHEMATYPE_ARRAY {
+			property = property.(*ArraySchema).Items
+		}
+
+		if isDecimal(property) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+	Returns true if any string used by the given schema (including array items) is binary content.
+*/
+func containsBinary(schema *ObjectSchema) bool {
+
+	for _, property := range getAllConstrainedSchemas(schema) {
+
+		for property.GetSchemaType() == SCHEMATYPE_ARRAY {
+			property = property.(*ArraySchema).Items
+		}
+
+		if property.GetSchemaType() == SCHEMATYPE_STRING && property.(*StringSchema).IsBinary() {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+	Returns the given integer [value] as a decimal string. Accepts any of the integer types used for schema bounds.
+*/
+func getIntegerString(value interface{}) string {
+
+	switch value.(type) {
+	case *big.Int:
+		return value.(*big.Int).String()
+	}
+
+	return fmt.Sprintf("%d", value)
+}
@@ -2,6 +2,8 @@ package presilo
 
 import (
 	"fmt"
+	"math/big"
+	"path"
 	"regexp"
 	"strings"
 )
@@ -14,21 +16,29 @@ import (
 	Unfortunately this isn't available before .NET 4.5, so any generated code
 	will need to be compiled with .NET 4.5+
 */
-func GenerateCSharp(schema *ObjectSchema, module string, tabstyle string) string {
+func GenerateCSharp(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
 
 	var buffer *BufferedFormatString
+	var model *ModelType
+
+	warnUncheckedNots(schema, "cs", "C#")
 
 	buffer = NewBufferedFormatString(tabstyle)
+	model = NewModelType(schema)
 
 	generateCSharpImports(schema, buffer)
 	buffer.Print("\n")
 	generateCSharpNamespace(schema, buffer, module)
 	buffer.Print("\n")
-	generateCSharpTypeDeclaration(schema, buffer)
+	generateCSharpTypeDeclaration(model, options, buffer)
+	buffer.Print("\n")
+	generateCSharpConstructor(model, buffer)
 	buffer.Print("\n")
-	generateCSharpConstructor(schema, buffer)
+	generateCSharpFunctions(model, buffer)
+	generateCSharpConditions(schema, buffer)
+	generateCSharpValidation(model, buffer)
 	buffer.Print("\n")
-	generateCSharpFunctions(schema, buffer)
+	generateProtectedRegion(ToCamelCase(schema.GetTitle()), "//", buffer)
 	buffer.AddIndentation(-1)
 	buffer.Print("\n}")
 	buffer.AddIndentation(-1)
@@ -44,6 +54,23 @@ func ValidateCSharpModule(module string) bool {
 	return err == nil && matched
 }
 
+/*
+	Returns the path of the file the given [schema] is written to; in a directory for each part of its namespace, named after its class.
+*/
+func GetCSharpFileName(schema *ObjectSchema, module string) string {
+	return path.Join(strings.Replace(module, ".", "/", -1), ToCamelCase(schema.GetTitle()))
+}
+
+/*
+	Combines the code of several classes into one file, with each using directive once, before every namespace.
+*/
+func CombineCSharpFiles(code []string, module string) (string, error) {
+
+	return combineHeaderLines(code, func(line string) bool {
+		return strings.HasPrefix(line, "using ")
+	}), nil
+}
+
 func generateCSharpImports(schema *ObjectSchema, buffer *BufferedFormatString) {
 
 	buffer.Print("using System;")
@@ -54,6 +81,14 @@ func generateCSharpImports(schema *ObjectSchema, buffer *BufferedFormatString) {
 		buffer.Print("\nusing System.Text.RegularExpressions;")
 	}
 
+	if containsBigInteger(schema) {
+		buffer.Print("\nusing System.Numerics;")
+	}
+
+	for _, namespace := range getExtensionImports(schema, "cs") {
+		buffer.Printf("\nusing %s;", namespace)
+	}
+
 	buffer.Print("\n")
 }
 
@@ -63,37 +98,149 @@ func generateCSharpNamespace(schema *ObjectSchema, buffer *BufferedFormatString,
 	buffer.AddIndentation(1)
 }
 
-func generateCSharpTypeDeclaration(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateCSharpTypeDeclaration(model *ModelType, options GeneratorOptions, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var subschema TypeSchema
-	var propertyName string
+	var propertyName, fieldName, visibility string
+
+	schema = model.Schema
 
 	buffer.Print("[DataContract]")
+	generateCSharpDeprecation(schema, buffer)
 	buffer.Printf("\npublic class %s\n{", ToCamelCase(schema.Title))
 	buffer.AddIndentation(1)
 
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
+	visibility = getFieldVisibility(options)
+
+	for _, field := range model.Fields {
+
+		subschema = field.Schema
+		propertyName = field.Name
+		fieldName = getCSharpFieldName(schema, propertyName)
+
+		// writeOnly and binary fields are (de)serialized through a property instead.
+		if subschema.IsWriteOnly() || isCSharpBinary(subschema) {
+			buffer.Print("\n[IgnoreDataMember]")
+		} else {
+			buffer.Printf("\n[DataMember(Name = \"%s\")]", propertyName)
+		}
+
+		generateCSharpDeprecation(subschema, buffer)
+
+		if field.IsConst {
+			if subschema.GetSchemaType() == SCHEMATYPE_INTEGER {
+				buffer.Printf("\n%s readonly %s %s = %s;", visibility, GenerateCSharpTypeForSchema(subschema), fieldName, getCSharpIntegerLiteral(subschema.(*IntegerSchema), field.Const))
+				continue
+			}
+
+			if isDecimal(subschema) {
+				buffer.Printf("\n%s readonly %s %s = %s;", visibility, GenerateCSharpTypeForSchema(subschema), fieldName, getCSharpDecimalLiteral(field.Const))
+				continue
+			}
+
+			buffer.Printf("\n%s readonly %s %s = %s;", visibility, GenerateCSharpTypeForSchema(subschema), fieldName, getConstLiteral(field.Const, "true", "false"))
+			continue
+		}
+
+		buffer.Printf("\n%s %s %s;", visibility, GenerateCSharpTypeForSchema(subschema), fieldName)
+
+		if isCSharpBinary(subschema) {
+			generateCSharpBase64Property(subschema, propertyName, fieldName, buffer)
+			continue
+		}
+
+		if subschema.IsWriteOnly() {
+			generateCSharpWriteOnlyProperty(subschema, propertyName, fieldName, buffer)
+		}
+	}
+}
+
+/*
+	Returns true if the given schema is binary content, or an array of it.
+	DataContractJsonSerializer writes byte arrays as arrays of numbers, so these need base64 conversion.
+*/
+func isCSharpBinary(schema TypeSchema) bool {
+
+	if schema.GetSchemaType() == SCHEMATYPE_ARRAY {
+		schema = schema.(*ArraySchema).Items
+	}
+
+	return schema.GetSchemaType() == SCHEMATYPE_STRING && schema.(*StringSchema).IsBinary()
+}
+
+/*
+	Generates a private property which deserializes the given writeOnly property,
+	but is never serialized; its getter always returns the default value, which "EmitDefaultValue = false" leaves out.
+*/
+func generateCSharpWriteOnlyProperty(schema TypeSchema, propertyName string, fieldName string, buffer *BufferedFormatString) {
+
+	var typeName string
+
+	typeName = GenerateCSharpTypeForSchema(schema)
+
+	buffer.Printf("\n[DataMember(Name = \"%s\", EmitDefaultValue = false)]", propertyName)
+	buffer.Printf("\nprivate %s %sWriteOnly\n{", typeName, fieldName)
+	buffer.AddIndentation(1)
+
+	buffer.Printf("\nget { return default(%s); }", typeName)
+	buffer.Printf("\nset { this.%s = value; }", fieldName)
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+}
+
+/*
+	Generates a private property which (de)serializes the given binary property as base64.
+	If the property is writeOnly, it's only ever deserialized.
+*/
+func generateCSharpBase64Property(schema TypeSchema, propertyName string, fieldName string, buffer *BufferedFormatString) {
+
+	var encoded, decoded, typeName, emitDefault string
 
-		subschema = schema.Properties[propertyName]
+	if schema.GetSchemaType() == SCHEMATYPE_ARRAY {
+		typeName = "string[]"
+		encoded = fmt.Sprintf("Array.ConvertAll(this.%s, Convert.ToBase64String)", fieldName)
+		decoded = "Array.ConvertAll(value, Convert.FromBase64String)"
+	} else {
+		typeName = "string"
+		encoded = fmt.Sprintf("Convert.ToBase64String(this.%s)", fieldName)
+		decoded = "Convert.FromBase64String(value)"
+	}
 
-		buffer.Printf("\n[DataMember(Name = \"%s\")]", propertyName)
-		buffer.Printf("\nprotected %s %s;", GenerateCSharpTypeForSchema(subschema), ToJavaCase(propertyName))
+	if schema.IsWriteOnly() {
+		encoded = "null"
+		emitDefault = ", EmitDefaultValue = false"
+	} else {
+		encoded = fmt.Sprintf("this.%s == null ? null : %s", fieldName, encoded)
 	}
+
+	buffer.Printf("\n[DataMember(Name = \"%s\"%s)]", propertyName, emitDefault)
+	buffer.Printf("\nprivate %s %sBase64\n{", typeName, fieldName)
+	buffer.AddIndentation(1)
+
+	buffer.Printf("\nget { return %s; }", encoded)
+	buffer.Printf("\nset { this.%s = value == null ? null : %s; }", fieldName, decoded)
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
 }
 
-func generateCSharpConstructor(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateCSharpConstructor(model *ModelType, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var subschema TypeSchema
 	var declarations, setters []string
 	var propertyName string
 	var toWrite string
 
+	schema = model.Schema
 	buffer.Printf("\npublic %s(", ToCamelCase(schema.Title))
 
-	for _, propertyName = range schema.RequiredProperties {
+	for _, field := range model.ConstructorFields {
 
-		subschema = schema.Properties[propertyName]
-		propertyName = ToJavaCase(propertyName)
+		subschema = field.Schema
+		propertyName = getCSharpFieldName(schema, field.Name)
 
 		toWrite = fmt.Sprintf("%s %s", GenerateCSharpTypeForSchema(subschema), propertyName)
 		declarations = append(declarations, toWrite)
@@ -114,20 +261,24 @@ func generateCSharpConstructor(schema *ObjectSchema, buffer *BufferedFormatStrin
 	buffer.Print("\n}\n")
 }
 
-func generateCSharpFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateCSharpFunctions(model *ModelType, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var subschema TypeSchema
-	var propertyName, properName, camelName, typeName string
+	var properName, camelName, typeName string
+
+	schema = model.Schema
 
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
+	for _, field := range model.Fields {
 
-		subschema = schema.Properties[propertyName]
+		subschema = field.Schema
 
-		properName = ToJavaCase(propertyName)
-		camelName = ToStrictCamelCase(propertyName)
+		properName = getCSharpFieldName(schema, field.Name)
+		camelName = ToStrictCamelCase(getOverriddenName(schema, field.Name, "cs"))
 		typeName = GenerateCSharpTypeForSchema(subschema)
 
 		// getter
+		generateCSharpDeprecation(subschema, buffer)
 		buffer.Printf("\npublic %s get%s()\n{", typeName, camelName)
 		buffer.AddIndentation(1)
 
@@ -136,109 +287,657 @@ func generateCSharpFunctions(schema *ObjectSchema, buffer *BufferedFormatString)
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}")
 
+		// readOnly and const fields are never set by consumers, no setter.
+		if !field.Settable {
+			buffer.Print("\n")
+			continue
+		}
+
 		// setter
+		generateCSharpDeprecation(subschema, buffer)
 		buffer.Printf("\npublic void set%s(%s value)\n{", camelName, typeName)
 		buffer.AddIndentation(1)
 
-		switch subschema.GetSchemaType() {
-		case SCHEMATYPE_STRING:
-			generateCSharpStringSetter(subschema.(*StringSchema), buffer)
-		case SCHEMATYPE_INTEGER:
-			fallthrough
-		case SCHEMATYPE_NUMBER:
-			generateCSharpNumericSetter(subschema.(NumericSchemaType), buffer)
-		case SCHEMATYPE_OBJECT:
-			generateCSharpObjectSetter(subschema.(*ObjectSchema), buffer)
-		case SCHEMATYPE_ARRAY:
-			generateCSharpArraySetter(subschema.(*ArraySchema), buffer)
+		// only a setter can be given null to check; everything else checks values once it knows they're there.
+		if !subschema.GetNullable() && len(getCSharpPresenceCheck(subschema, "value")) > 0 {
+			generateCSharpNullCheck(buffer)
 		}
 
+		generateCSharpChecks(subschema, failWithStatement, buffer)
+
 		buffer.Printf("\nthis.%s = value;", properName)
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
 	}
 }
 
-func generateCSharpStringSetter(schema *StringSchema, buffer *BufferedFormatString) {
+/*
+	Generates all the checks appropriate for the type of the given [subschema],
+	each of which reports (with [fail]) if 'value' violates a constraint.
+*/
+func generateCSharpChecks(subschema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
 
-	if !schema.Nullable {
-		generateCSharpNullCheck(buffer)
+	if hasTypeOverride(subschema, "cs") {
+		return
 	}
 
-	if schema.MinLength != nil {
-		generateCSharpRangeCheck(*schema.MinLength, "value.Length", "was shorter than allowable minimum", "%d", false, "<", "", buffer)
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING:
+		generateCSharpStringSetter(subschema.(*StringSchema), fail, buffer)
+	case SCHEMATYPE_INTEGER:
+		fallthrough
+	case SCHEMATYPE_NUMBER:
+		generateCSharpNumericSetter(subschema.(NumericSchemaType), fail, buffer)
+	case SCHEMATYPE_BOOLEAN:
+		generateCSharpBooleanSetter(subschema.(*BooleanSchema), fail, buffer)
+	case SCHEMATYPE_ARRAY:
+		generateCSharpArraySetter(subschema.(*ArraySchema), fail, buffer)
 	}
+}
+
+func generateCSharpStringSetter(schema *StringSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var pattern, enum *ModelRule
+	var byteLength string
 
-	if schema.MaxLength != nil {
-		generateCSharpRangeCheck(*schema.MaxLength, "value.Length", "was longer than allowable maximum", "%d", false, ">", "", buffer)
+	generateCSharpNotCheck(schema, fail, buffer)
+
+	if schema.IsBinary() {
+		byteLength = "value.Length"
+	} else {
+		byteLength = "value.Length * sizeof(Char)"
 	}
 
-	if schema.MinByteLength != nil {
-		generateCSharpRangeCheck(*schema.MinByteLength, "value.Length * sizeof(Char)", "had fewer bytes than allowable minimum", "%d", false, "<", "", buffer)
+	generateCSharpRangeChecks(schema, MODELSUBJECT_LENGTH, "value.Length", fail, buffer)
+	generateCSharpRangeChecks(schema, MODELSUBJECT_BYTE_LENGTH, byteLength, fail, buffer)
+
+	pattern = getModelRule(schema, MODELRULE_PATTERN)
+	if pattern != nil {
+
+		// the static IsMatch() needs no local, which might collide with one declared by a "not" check.
+		buffer.Printf("\nif(!Regex.IsMatch(value, \"%s\"))\n{", sanitizeQuotedString(pattern.Value.(string)))
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s", fail(pattern.Keyword, fmt.Sprintf("did not match pattern '%s'", pattern.Value), fmt.Sprintf("throw new Exception(\"Value '\"+value+\"' did not match pattern '%s'\");", pattern.Value)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}")
 	}
 
-	if schema.MaxByteLength != nil {
-		generateCSharpRangeCheck(*schema.MaxByteLength, "value.Length * sizeof(Char)", "had more bytes than allowable minimum", "%d", false, ">", "", buffer)
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateCSharpEnumCheck(schema, buffer, enum.Keyword, enum.Value.([]interface{}), "\"", "\"", fail)
 	}
+}
+
+func generateCSharpNumericSetter(schema NumericSchemaType, fail checkFailure, buffer *BufferedFormatString) {
 
-	if schema.Pattern != nil {
+	var enum, multiple *ModelRule
+	var multipleValue interface{}
+	var enumValues []interface{}
 
-		buffer.Printf("\nRegex regex = new Regex(\"%s\");", sanitizeQuotedString(*schema.Pattern))
-		buffer.Printf("\nif(!regex.IsMatch(value))\n{")
+	generateCSharpNotCheck(schema.(TypeSchema), fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+
+	if multiple != nil {
+		multipleValue = multiple.Value
+	}
+	if enum != nil {
+		enumValues = append(enumValues, enum.Value.([]interface{})...)
+	}
+
+	// integers may be wider than C# can write as a literal, so they're written out ahead of time.
+	if schema.GetSchemaType() == SCHEMATYPE_INTEGER {
+
+		multipleValue = getCSharpIntegerLiteral(schema.(*IntegerSchema), multipleValue)
+
+		for i, enumValue := range enumValues {
+			enumValues[i] = getCSharpIntegerLiteral(schema.(*IntegerSchema), enumValue)
+		}
+	}
+
+	// decimals need a suffix, or they'd be doubles (which can't be compared to decimals).
+	if isDecimal(schema.(TypeSchema)) {
+
+		multipleValue = getCSharpDecimalLiteral(multipleValue)
+
+		for i, enumValue := range enumValues {
+			enumValues[i] = getCSharpDecimalLiteral(enumValue)
+		}
+	}
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, MODELSUBJECT_VALUE) {
+		generateCSharpRangeCheck(rule, "value", getCSharpNumericLiteral(schema, rule.Value), fail, buffer)
+	}
+
+	if enum != nil {
+		generateCSharpEnumCheck(schema, buffer, enum.Keyword, enumValues, "", "", fail)
+	}
+
+	if multiple != nil {
+
+		buffer.Printf("\nif(value %% %v != 0)\n{", multipleValue)
 		buffer.AddIndentation(1)
 
-		buffer.Printf("\nthrow new Exception(\"Value '\"+value+\"' did not match pattern '%s'\");", *schema.Pattern)
+		buffer.Printf("\n%s", fail(multiple.Keyword, fmt.Sprintf("was not a multiple of %v", multiple.Value), fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' was not a multiple of %v\");", multiple.Value)))
 
 		buffer.AddIndentation(-1)
-		buffer.Print("\n}")
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Returns a C# expression for the given integer [value], appropriate for the width of the given schema.
+	BigInteger converts implicitly from long, so only values which don't fit in a long need to be parsed.
+*/
+func getCSharpIntegerLiteral(schema *IntegerSchema, value interface{}) string {
+
+	var bigValue *big.Int
+
+	if value == nil {
+		return ""
+	}
+
+	bigValue, _ = new(big.Int).SetString(getIntegerString(value), 10)
+
+	switch schema.GetWidth() {
+	case INTEGERWIDTH_64:
+		return bigValue.String() + "L"
+	case INTEGERWIDTH_BIG:
+
+		if bigValue.IsInt64() {
+			return bigValue.String() + "L"
+		}
+		return fmt.Sprintf("BigInteger.Parse(\"%s\")", bigValue.String())
+	}
+
+	return bigValue.String()
+}
+
+/*
+	Returns a C# literal for the given decimal [value].
+*/
+func getCSharpDecimalLiteral(value interface{}) string {
+
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%sm", value)
+}
+
+/*
+	Returns the given bound [value] of the given numeric [schema] as a literal of the schema's type.
+*/
+func getCSharpNumericLiteral(schema NumericSchemaType, value interface{}) string {
+
+	if schema.GetSchemaType() == SCHEMATYPE_INTEGER {
+		return getCSharpIntegerLiteral(schema.(*IntegerSchema), value)
 	}
+
+	if isDecimal(schema) {
+		return getCSharpDecimalLiteral(value)
+	}
+
+	return fmt.Sprintf(schema.GetConstraintFormat(), value)
+}
+
+func generateCSharpBooleanSetter(schema *BooleanSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum *ModelRule
+	var value interface{}
+
+	generateCSharpNotCheck(schema, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		value = enum.Value.([]interface{})[0]
+
+		buffer.Printf("\nif(value != %v)\n{", value)
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(enum.Keyword, fmt.Sprintf("must be %v", value), fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' must be %v.\");", value)))
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Generates code which throws if 'value' matches the "not" subschema of the given [schema].
+	The subschema's checks are run inside a try block, and the value is only valid if one of them throws.
+	This must be generated before any other checks, since C# won't let the subschema's locals
+	share a name with any declared in the enclosing scope.
+*/
+func generateCSharpNotCheck(schema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var not *ModelRule
+	var flag string
+
+	not = getModelRule(schema, MODELRULE_NOT)
+	if not == nil {
+		return
+	}
+
+	// nested checks can't redeclare locals, so each level of nesting gets its own flag.
+	flag = fmt.Sprintf("matchesNot%d", getNotDepth(schema))
+
+	buffer.Printf("\nbool %s = true;", flag)
+	buffer.Print("\ntry\n{")
+	buffer.AddIndentation(1)
+
+	generateCSharpChecks(not.Value.(TypeSchema), failWithStatement, buffer)
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\ncatch(Exception)\n{")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s = false;", flag)
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	buffer.Printf("\nif(%s)\n{", flag)
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(not.Keyword, not.Message, "throw new Exception(\"Property '\"+value+\"' matched a schema which it must not match.\");"))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+func generateCSharpArraySetter(schema *ArraySchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	generateCSharpRangeChecks(schema, MODELSUBJECT_ITEMS, "value.Length", fail, buffer)
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_UNIQUE, MODELSUBJECT_ITEMS) {
+		generateCSharpUniqueCheck(rule, fail, buffer)
+	}
+}
+
+/*
+	Generates a check which reports (with [fail]) if any two items of 'value' are equal, violating the given unique [rule].
+	Items are compared structurally, since arrays (such as binary content) are otherwise only equal to themselves.
+*/
+func generateCSharpUniqueCheck(rule *ModelRule, fail checkFailure, buffer *BufferedFormatString) {
+
+	buffer.Print("\nbool duplicated = false;")
+	buffer.Print("\nfor(int i = 0; i < value.Length && !duplicated; i++)\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nfor(int j = i + 1; j < value.Length && !duplicated; j++)\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nduplicated = System.Collections.StructuralComparisons.StructuralEqualityComparer.Equals(value[i], value[j]);")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	buffer.Print("\nif(duplicated)\n{")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(rule.Keyword, rule.Message, fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' %s.\");", rule.Message)))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
 }
 
-func generateCSharpNumericSetter(schema NumericSchemaType, buffer *BufferedFormatString) {
+/*
+	Generates a "collectConditionalViolations" method for the given schema, which checks constraints that span multiple properties;
+	if/then/else, dependentRequired, and dependentSchemas. collectViolations() adds whatever it finds.
+	The "if" schema is generated as a private method which throws on the first violation found, since it only decides
+	which of "then" or "else" applies. Each other conditional schema is generated as its own private method,
+	which adds a violation for each field (beneath the given prefix) which doesn't meet it.
+*/
+func generateCSharpConditions(schema *ObjectSchema, buffer *BufferedFormatString) {
+
+	var presence, dependencyPresence string
+
+	if !schema.HasConditions() {
+		return
+	}
+
+	buffer.Print("\nprivate void collectConditionalViolations(string prefix, System.Collections.Generic.List<string> violations)\n{")
+	buffer.AddIndentation(1)
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		buffer.Print("\nbool matched = true;")
+		buffer.Print("\ntry\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nvalidateIf();")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\ncatch(Exception)\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nmatched = false;")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+
+		if schema.Then != nil {
+
+			buffer.Print("\nif(matched)\n{")
+			buffer.AddIndentation(1)
+			buffer.Print("\ncollectThenConditionViolations(prefix, violations);")
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+
+			if schema.Else != nil {
+				buffer.Print("\nelse\n{")
+				buffer.AddIndentation(1)
+				buffer.Print("\ncollectElseConditionViolations(prefix, violations);")
+				buffer.AddIndentation(-1)
+				buffer.Print("\n}")
+			}
+		} else {
+			buffer.Print("\nif(!matched)\n{")
+			buffer.AddIndentation(1)
+			buffer.Print("\ncollectElseConditionViolations(prefix, violations);")
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+		buffer.Print("\n")
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		presence = getCSharpPresenceCheck(schema.Properties[propertyName], "this."+getCSharpFieldName(schema, propertyName))
+
+		// properties which always have a value get an unconditional block.
+		if len(presence) > 0 {
+			buffer.Printf("\nif(%s)\n{", presence)
+		} else {
+			buffer.Print("\n{")
+		}
+
+		buffer.AddIndentation(1)
+
+		for _, dependency := range schema.DependentRequired[propertyName] {
+
+			dependencyPresence = getCSharpPresenceCheck(schema.Properties[dependency], "this."+getCSharpFieldName(schema, dependency))
+			if len(dependencyPresence) == 0 {
+				continue
+			}
+
+			buffer.Printf("\nif(!(%s))\n{", dependencyPresence)
+			buffer.AddIndentation(1)
+			buffer.Printf("\nviolations.Add(prefix + \"%s\");", getDependentRequiredViolationText(dependency, propertyName))
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+
+		if _, found := schema.DependentSchemas[propertyName]; found {
+			buffer.Printf("\ncollectDependent%sConditionViolations(prefix, violations);", ToStrictCamelCase(propertyName))
+		}
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
 
-	if schema.HasMinimum() {
-		generateCSharpRangeCheck(schema.GetMinimum(), "value", "is under the allowable minimum", schema.GetConstraintFormat(), schema.IsExclusiveMinimum(), "<=", "<", buffer)
+		generateCSharpCondition(schema, schema.If, "If", false, buffer)
+
+		if schema.Then != nil {
+			generateCSharpCondition(schema, schema.Then, "Then", true, buffer)
+		}
+		if schema.Else != nil {
+			generateCSharpCondition(schema, schema.Else, "Else", true, buffer)
+		}
 	}
 
-	if schema.HasMaximum() {
-		generateCSharpRangeCheck(schema.GetMaximum(), "value", "is over the allowable maximum", schema.GetConstraintFormat(), schema.IsExclusiveMaximum(), ">=", ">", buffer)
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		if condition, found := schema.DependentSchemas[propertyName]; found {
+			generateCSharpCondition(schema, condition, "Dependent"+ToStrictCamelCase(propertyName), true, buffer)
+		}
 	}
+}
 
-	if schema.HasEnum() {
-		generateCSharpEnumCheck(schema, buffer, schema.GetEnum(), "", "")
+/*
+	Generates a private method which checks the given [condition] against an instance of [schema].
+	If [collect]ing, the method adds a violation to the given list for each field which doesn't meet the condition
+	(described with the given prefix before its name); otherwise it throws on the first.
+	Constraints on properties are checked by reusing the setter checks against a local "value".
+	Properties which aren't present are not checked.
+*/
+func generateCSharpCondition(schema *ObjectSchema, condition *ConditionalSchema, name string, collect bool, buffer *BufferedFormatString) {
+
+	var subschema TypeSchema
+	var failure checkFailure
+	var reference, presence string
+
+	if collect {
+		buffer.Printf("\nprivate void collect%sConditionViolations(string prefix, System.Collections.Generic.List<string> violations)\n{", name)
+	} else {
+		buffer.Printf("\nprivate void validate%s()\n{", name)
 	}
+	buffer.AddIndentation(1)
+
+	for _, propertyName := range condition.RequiredProperties {
 
-	if schema.HasMultiple() {
+		presence = getCSharpPresenceCheck(schema.Properties[propertyName], "this."+getCSharpFieldName(schema, propertyName))
+		if len(presence) == 0 {
+			continue
+		}
 
-		buffer.Printf("\nif(value %% %f != 0)\n{", schema.GetMultiple())
+		buffer.Printf("\nif(!(%s))\n{", presence)
 		buffer.AddIndentation(1)
 
-		buffer.Printf("\nthrow new Exception(\"Property '\"+value+\"' was not a multiple of %s\");", schema.GetMultiple())
+		if collect {
+			buffer.Printf("\nviolations.Add(prefix + \"%s: required: is required\");", sanitizeQuotedString(propertyName))
+		} else {
+			buffer.Printf("\nthrow new Exception(\"Property '%s' is required\");", propertyName)
+		}
 
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
 	}
+
+	for _, propertyName := range condition.GetOrderedPropertyNames() {
+
+		subschema = condition.Properties[propertyName]
+		if !subschema.HasConstraints() || hasTypeOverride(schema.Properties[propertyName], "cs") {
+			continue
+		}
+
+		reference = "this." + getCSharpFieldName(schema, propertyName)
+		presence = getCSharpPresenceCheck(schema.Properties[propertyName], reference)
+
+		if len(presence) > 0 {
+			buffer.Printf("\nif(%s)", presence)
+		}
+
+		buffer.Print("\n{")
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s value = %s;", GenerateCSharpTypeForSchema(subschema), reference)
+
+		if collect {
+
+			failure = chooseViolation(schema.Properties[propertyName], getCSharpViolation, getCSharpWriteOnlyViolation)
+			buffer.Printf("\nstring path = prefix + \"%s\";", sanitizeQuotedString(propertyName))
+		} else {
+			failure = failWithStatement
+		}
+
+		generateCSharpChecks(subschema, failure, buffer)
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
 }
 
-func generateCSharpObjectSetter(schema *ObjectSchema, buffer *BufferedFormatString) {
+/*
+	Generates a "validate" method for the given schema, which checks every constraint of every field (and of every object they hold),
+	and throws an exception which lists every violation, rather than stopping at the first.
+	The work is done by "collectViolations", which objects holding this one call with their own path.
+*/
+func generateCSharpValidation(model *ModelType, buffer *BufferedFormatString) {
+
+	var schema *ObjectSchema
+	var fields []*ModelField
+	var reference string
+
+	schema = model.Schema
+	fields = getValidatedFields(model, "cs")
 
-	if !schema.Nullable {
-		generateCSharpNullCheck(buffer)
+	buffer.Print("\npublic void validate()\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nSystem.Collections.Generic.List<string> violations = collectViolations(\"\", new System.Collections.Generic.List<string>());")
+	buffer.Print("\nif(violations.Count > 0)\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nthrow new Exception(String.Join(\"\\n\", violations));")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	buffer.Print("\npublic System.Collections.Generic.List<string> collectViolations(string path, System.Collections.Generic.List<string> violations)\n{")
+	buffer.AddIndentation(1)
+
+	if len(fields) > 0 || schema.HasConditions() {
+		buffer.Print("\nstring prefix = path.Length == 0 ? \"\" : path + \".\";\n")
+	}
+
+	for _, field := range fields {
+
+		reference = "this." + getCSharpFieldName(schema, field.Name)
+
+		// optional primitives can't be told apart from one that was never set while they're zero, so they're only checked once they aren't.
+		if isOptionalPrimitive(field, getCSharpPresenceCheck(field.Schema, reference)) {
+			buffer.Printf("\nif(%s != 0)\n{", reference)
+			buffer.AddIndentation(1)
+		}
+
+		buffer.Printf("\ncollect%sViolations(prefix + \"%s\", %s, violations);", ToStrictCamelCase(getOverriddenName(schema, field.Name, "cs")), sanitizeQuotedString(field.Name), reference)
+
+		if isOptionalPrimitive(field, getCSharpPresenceCheck(field.Schema, reference)) {
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+	}
+
+	if len(fields) > 0 {
+		buffer.Print("\n")
+	}
+
+	if schema.HasConditions() {
+		buffer.Print("\ncollectConditionalViolations(prefix, violations);\n")
+	}
+
+	buffer.Print("\nreturn violations;")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	for _, field := range fields {
+		generateCSharpValueValidation(field.Schema, chooseViolation(field.Schema, getCSharpViolation, getCSharpWriteOnlyViolation), ToStrictCamelCase(getOverriddenName(schema, field.Name, "cs")), field.Required, buffer)
 	}
 }
 
-func generateCSharpArraySetter(schema *ArraySchema, buffer *BufferedFormatString) {
+/*
+	Generates a private method which checks a value of the given [subschema], and anything it holds,
+	named after the given (camel-cased) [name]. Each field (and the items of each array) has its own method,
+	since C# won't let the locals declared by one check share a name with those of another in an enclosing scope.
+	A value which is null is only a violation if it's [required]; primitives always have a value, and are always checked.
+*/
+func generateCSharpValueValidation(subschema TypeSchema, failure checkFailure, name string, required bool, buffer *BufferedFormatString) {
+
+	var items TypeSchema
+	var presence string
+
+	buffer.Printf("\nprivate void collect%sViolations(string path, %s value, System.Collections.Generic.List<string> violations)\n{", name, GenerateCSharpTypeForSchema(subschema))
+	buffer.AddIndentation(1)
+
+	presence = getCSharpPresenceCheck(subschema, "value")
+	if len(presence) > 0 {
 
-	if !schema.Nullable {
-		generateCSharpNullCheck(buffer)
+		buffer.Printf("\nif(!(%s))\n{", presence)
+		buffer.AddIndentation(1)
+
+		if required {
+			buffer.Print("\nviolations.Add(path + \": required: is required\");")
+		}
+
+		buffer.Print("\nreturn;")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
 	}
 
-	if schema.MinItems != nil {
-		generateCSharpRangeCheck(*schema.MinItems, "value.Length", "does not have enough items", "%d", false, "<", "", buffer)
+	generateCSharpChecks(subschema, failure, buffer)
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_OBJECT:
+		buffer.Print("\nvalue.collectViolations(path, violations);")
+
+	case SCHEMATYPE_ARRAY:
+
+		items = subschema.(*ArraySchema).Items
+		if needsValidation(items, "cs") {
+
+			buffer.Print("\nfor(int i = 0; i < value.Length; i++)\n{")
+			buffer.AddIndentation(1)
+			buffer.Printf("\ncollect%sItemViolations(path + \"[\" + i + \"]\", value[i], violations);", name)
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
 	}
 
-	if schema.MaxItems != nil {
-		generateCSharpRangeCheck(*schema.MaxItems, "value.Length", "does not have enough items", "%d", false, ">", "", buffer)
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	if subschema.GetSchemaType() == SCHEMATYPE_ARRAY && needsValidation(items, "cs") {
+		generateCSharpValueValidation(items, failure, name+"Item", false, buffer)
+	}
+}
+
+/*
+	A checkFailure which adds a description of the violation (with the path in 'path', and the offending 'value') to 'violations'.
+*/
+func getCSharpViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.Add(path + \"%s\" + value);", getViolationText(keyword, description))
+}
+
+/*
+	A checkFailure like getCSharpViolation, for writeOnly values, which leaves the value out of the description.
+*/
+func getCSharpWriteOnlyViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.Add(path + \"%s\");", getWriteOnlyViolationText(keyword, description))
+}
+
+/*
+	Returns an expression which is true if the field at [reference] has been given a value,
+	or an empty string if the field is a primitive (and so always has one), or its type is overridden.
+*/
+func getCSharpPresenceCheck(subschema TypeSchema, reference string) string {
+
+	if len(getExtensionOverride(subschema, "cs", EXTENSION_TYPE)) > 0 {
+		return ""
+	}
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING:
+		fallthrough
+	case SCHEMATYPE_OBJECT:
+		fallthrough
+	case SCHEMATYPE_ARRAY:
+		return reference + " != null"
+	}
+
+	return ""
+}
+
+/*
+	Returns the name of the field which holds the given property, using its "x-cs-name" if it has one.
+*/
+func getCSharpFieldName(schema *ObjectSchema, propertyName string) string {
+	return ToJavaCase(getOverriddenName(schema, propertyName, "cs"))
+}
+
+/*
+	Generates an [Obsolete] attribute if the given schema is deprecated.
+*/
+func generateCSharpDeprecation(schema TypeSchema, buffer *BufferedFormatString) {
+
+	if schema.IsDeprecated() {
+		buffer.Print("\n[Obsolete]")
 	}
 }
 
@@ -253,28 +952,33 @@ func generateCSharpNullCheck(buffer *BufferedFormatString) {
 	buffer.Print("\n}\n")
 }
 
-func generateCSharpRangeCheck(value interface{}, reference, message, format string, exclusive bool, comparator, exclusiveComparator string, buffer *BufferedFormatString) {
-
-	var compareString string
+/*
+	Generates a check for every range rule of the given [schema] on the given (counted) [subject], comparing [reference] to each bound.
+*/
+func generateCSharpRangeChecks(schema TypeSchema, subject ModelSubject, reference string, fail checkFailure, buffer *BufferedFormatString) {
 
-	if exclusive {
-		compareString = exclusiveComparator
-	} else {
-		compareString = comparator
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, subject) {
+		generateCSharpRangeCheck(rule, reference, fmt.Sprintf("%d", rule.Value), fail, buffer)
 	}
+}
+
+/*
+	Generates a check which reports (with [fail]) if [reference] violates the given range [rule], with the bound written as [value].
+*/
+func generateCSharpRangeCheck(rule *ModelRule, reference string, value string, fail checkFailure, buffer *BufferedFormatString) {
 
-	buffer.Printf("\nif(%s %s "+format+")\n{", reference, compareString, value)
+	buffer.Printf("\nif(%s %s %s)\n{", reference, rule.Violation, value)
 	buffer.AddIndentation(1)
 
-	buffer.Printf("\nthrow new Exception(\"Property '\"+value+\"' %s.\");", message)
+	buffer.Printf("\n%s", fail(rule.Keyword, fmt.Sprintf("%s (%v)", rule.Message, rule.Value), fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' %s.\");", rule.Message)))
 	buffer.AddIndentation(-1)
 	buffer.Print("\n}\n")
 }
 
 /*
-	Generates code which throws an error if the given [parameter]'s value is not contained in the given [validValues].
+	Generates code which reports (with [fail]) a violation of the given enum [keyword] if 'value' is not contained in the given [enumValues].
 */
-func generateCSharpEnumCheck(schema TypeSchema, buffer *BufferedFormatString, enumValues []interface{}, prefix string, postfix string) {
+func generateCSharpEnumCheck(schema TypeSchema, buffer *BufferedFormatString, keyword string, enumValues []interface{}, prefix string, postfix string, fail checkFailure) {
 
 	var typeName string
 	var length int
@@ -285,9 +989,14 @@ func generateCSharpEnumCheck(schema TypeSchema, buffer *BufferedFormatString, en
 		return
 	}
 
+	// C# won't let a nested scope reuse a local's name, even if the nested scope comes first,
+	// so the locals are kept in their own scope in case "not" checks declared them too.
+	buffer.Print("\n{")
+	buffer.AddIndentation(1)
+
 	// write array of valid values
 	typeName = GenerateCSharpTypeForSchema(schema)
-	buffer.Printf("%s[] validValues = new %s[]{%s%v%s", typeName, typeName, prefix, enumValues[0], postfix)
+	buffer.Printf("\n%s[] validValues = new %s[]{%s%v%s", typeName, typeName, prefix, enumValues[0], postfix)
 
 	for _, enumValue := range enumValues[1:length] {
 		buffer.Printf(",%s%v%s", prefix, enumValue, postfix)
@@ -311,24 +1020,49 @@ func generateCSharpEnumCheck(schema TypeSchema, buffer *BufferedFormatString, en
 
 	buffer.Print("\nif(!isValid)\n{")
 	buffer.AddIndentation(1)
-	buffer.Print("\nthrow new Exception(\"Given value '\"+value+\"' was not found in list of acceptable values\");")
+	buffer.Printf("\n%s", fail(keyword, "was not found in list of acceptable values", "throw new Exception(\"Given value '\"+value+\"' was not found in list of acceptable values\");"))
 
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
 	buffer.AddIndentation(-1)
 	buffer.Print("\n}\n")
 }
 
 func GenerateCSharpTypeForSchema(subschema TypeSchema) string {
 
+	var override string
+
+	// "x-cs-type" replaces whatever type would otherwise be used.
+	override = getExtensionOverride(subschema, "cs", EXTENSION_TYPE)
+	if len(override) > 0 {
+		return override
+	}
+
 	switch subschema.GetSchemaType() {
 	case SCHEMATYPE_NUMBER:
+
+		if subschema.(*NumberSchema).IsDecimal() {
+			return "decimal"
+		}
 		return "double"
 	case SCHEMATYPE_INTEGER:
+
+		switch subschema.(*IntegerSchema).GetWidth() {
+		case INTEGERWIDTH_64:
+			return "long"
+		case INTEGERWIDTH_BIG:
+			return "BigInteger"
+		}
 		return "int"
 	case SCHEMATYPE_ARRAY:
-		return ToCamelCase(subschema.(*ArraySchema).Items.GetTitle()) + "[]"
+		return GenerateCSharpTypeForSchema(subschema.(*ArraySchema).Items) + "[]"
 	case SCHEMATYPE_OBJECT:
 		return ToCamelCase(subschema.GetTitle())
 	case SCHEMATYPE_STRING:
+
+		if subschema.(*StringSchema).IsBinary() {
+			return "byte[]"
+		}
 		return "string"
 	case SCHEMATYPE_BOOLEAN:
 		return "bool"
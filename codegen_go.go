@@ -2,28 +2,46 @@ package presilo
 
 import (
 	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math/big"
 	"regexp"
+	"sort"
 	"strings"
 )
 
 /*
   Generates valid Go code for a given schema.
 */
-func GenerateGo(schema *ObjectSchema, module string, tabstyle string) string {
+func GenerateGo(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
 
 	var buffer *BufferedFormatString
+	var model *ModelType
+
+	warnUncheckedNots(schema, "go", "Go")
 
 	buffer = NewBufferedFormatString(tabstyle)
+	model = NewModelType(schema)
 
 	buffer.Printf("package %s", module)
 	buffer.Print("\n")
-	generateGoImports(schema, buffer)
+	generateGoImports(model, buffer)
+	buffer.Print("\n")
+	generateGoTypeDeclaration(model, buffer)
+	buffer.Print("\n")
+	generateGoConstructor(model, buffer)
+	buffer.Print("\n")
+	generateGoFunctions(model, options, buffer)
 	buffer.Print("\n")
-	generateGoTypeDeclaration(schema, buffer)
+	generateGoSerializer(model, buffer)
 	buffer.Print("\n")
-	generateGoConstructor(schema, buffer)
+	generateGoValidation(model, buffer)
 	buffer.Print("\n")
-	generateGoFunctions(schema, buffer)
+	generateGoConditions(schema, buffer)
+	buffer.Print("\n")
+	generateProtectedRegion(ToCamelCase(schema.GetTitle()), "//", buffer)
 	buffer.Print("\n")
 
 	return buffer.String()
@@ -35,25 +53,123 @@ func ValidateGoModule(module string) bool {
 	return err == nil && matched
 }
 
-func generateGoImports(schema *ObjectSchema, buffer *BufferedFormatString) {
+/*
+	Combines the code of several types into one file, in the given [module], with one package clause and one import block.
+	Fails if any of the given [code] can't be parsed.
+*/
+func CombineGoFiles(code []string, module string) (string, error) {
+
+	var fileSet *token.FileSet
+	var parsed *ast.File
+	var buffer bytes.Buffer
+	var imports, bodies []string
+	var importPath string
+	var start int
+	var err error
+
+	fileSet = token.NewFileSet()
+
+	for _, file := range code {
+
+		parsed, err = parser.ParseFile(fileSet, "", file, parser.ImportsOnly)
+		if err != nil {
+			return "", err
+		}
+
+		// everything after the last import declaration (or the package clause, if there are none) is kept as it is.
+		start = fileSet.Position(parsed.Name.End()).Offset
+		for _, declaration := range parsed.Decls {
+			start = fileSet.Position(declaration.End()).Offset
+		}
+
+		for _, spec := range parsed.Imports {
+
+			importPath = spec.Path.Value
+			if spec.Name != nil {
+				importPath = spec.Name.Name + " " + importPath
+			}
+
+			if !arrayContainsString(imports, importPath) {
+				imports = append(imports, importPath)
+			}
+		}
+
+		bodies = append(bodies, file[start:])
+	}
+
+	sort.Strings(imports)
+
+	buffer.WriteString(fmt.Sprintf("package %s\n", module))
+
+	if len(imports) > 0 {
+		buffer.WriteString("\nimport (\n")
+		for _, importPath = range imports {
+			buffer.WriteString(importPath + "\n")
+		}
+		buffer.WriteString(")\n")
+	}
+
+	for _, body := range bodies {
+		buffer.WriteString(body)
+	}
+
+	return buffer.String(), nil
+}
+
+func generateGoImports(model *ModelType, buffer *BufferedFormatString) {
 
 	var imports []string
+	var schema, checked *ObjectSchema
 
-	// import errors if there are any constrained fields
-	if len(schema.ConstrainedProperties) > 0 {
-		imports = append(imports, "errors")
+	schema = model.Schema
+
+	// properties with overridden types are never checked, so don't need anything imported for them.
+	checked = withoutTypeOverrides(schema, "go")
+
+	// decimals are kept as json.Number, so that they're serialized exactly.
+	// writeOnly fields need a MarshalJSON which leaves them out.
+	if containsDecimal(checked) || len(model.GetWriteOnlyFields()) > 0 {
+		imports = append(imports, "encoding/json")
+	}
+
+	// every type has a Validate method, which joins its violations into one error.
+	imports = append(imports, "errors")
+
+	// violations are described with the value which caused them.
+	if goViolationsNeedFmt(checked) {
+		imports = append(imports, "fmt")
 	}
 
 	// if any string schema has a pattern match, import regex.
-	if containsRegexpMatch(schema) {
+	if containsRegexpMatch(checked) {
 		imports = append(imports, "regexp")
 	}
 
 	// if any number (but not integer!) has a multiple clause, import math
-	if containsNumberMod(schema) {
+	if containsNumberMod(checked) {
 		imports = append(imports, "math")
 	}
 
+	// arbitrary-precision integers, and decimals (which are checked as big.Rat)
+	if containsBigInteger(checked) || containsDecimal(checked) {
+		imports = append(imports, "math/big")
+	}
+
+	// unique items are compared deeply, since they may be slices or pointers.
+	if containsUniqueItems(checked) {
+		imports = append(imports, "reflect")
+	}
+
+	imports = append(imports, "strings")
+
+	// anything requested by the schema itself, for its overridden types.
+	for _, packageName := range getExtensionImports(schema, "go") {
+
+		if !arrayContainsString(imports, packageName) {
+			imports = append(imports, packageName)
+		}
+	}
+
 	// write imports (if they exist)
 	if len(imports) > 0 {
 
@@ -72,21 +188,25 @@ func generateGoImports(schema *ObjectSchema, buffer *BufferedFormatString) {
 	and struct tags.
 	Also includes the doc comments.
 */
-func generateGoTypeDeclaration(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateGoTypeDeclaration(model *ModelType, buffer *BufferedFormatString) {
 
-	var subschema TypeSchema
-	var propertyName string
+	var schema *ObjectSchema
+
+	schema = model.Schema
 
 	// description first
 	buffer.Printf("/*\n%s\n*/\n", schema.GetDescription())
+
+	if schema.IsDeprecated() {
+		buffer.Printf("// Deprecated: %s is marked as deprecated by its schema.\n", schema.GetTitle())
+	}
+
 	buffer.Printf("type %s struct {", schema.GetTitle())
 	buffer.AddIndentation(1)
 
 	// write all required fields as unexported fields.
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
-
-		subschema = schema.Properties[propertyName]
-		generateVariableDeclaration(subschema, buffer, propertyName, ToStrictCamelCase)
+	for _, field := range model.Fields {
+		generateVariableDeclaration(field.Schema, buffer, field.Name, getAppropriateGoCase(schema, field.Name))
 	}
 
 	buffer.AddIndentation(-1)
@@ -95,41 +215,47 @@ func generateGoTypeDeclaration(schema *ObjectSchema, buffer *BufferedFormatStrin
 
 /*
 	Generates getters and setters for all fields in the given schema
-	which have constraints.
-	fields without constraints are assumed to be exported.
+	which have constraints (or every field, if the options ask for it).
+	Fields are always exported, since encoding/json can't reach them otherwise.
 */
-func generateGoFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateGoFunctions(model *ModelType, options GeneratorOptions, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var subschema TypeSchema
 	var propertyName string
 
-	for _, propertyName = range schema.ConstrainedProperties {
+	schema = model.Schema
 
-		subschema = schema.Properties[propertyName]
-		propertyName = ToStrictCamelCase(propertyName)
+	for _, field := range getAccessorFields(model, options) {
+
+		subschema = field.Schema
+		propertyName = getAppropriateGoCase(schema, field.Name)
 
 		// getter
+		generateGoDeprecation(subschema, propertyName, buffer)
 		buffer.Printf("\nfunc (this *%s) Get%s() (%s) {", schema.GetTitle(), propertyName, GenerateGoTypeForSchema(subschema))
 		buffer.AddIndentation(1)
 		buffer.Printf("\nreturn this.%s", propertyName)
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
 
+		// readOnly and const fields are never set by the user, no setter.
+		if !field.Settable {
+			continue
+		}
+
 		// setter
-		buffer.Printf("func (this *%s) Set%s(value %s) (error) {", schema.GetTitle(), propertyName, GenerateGoTypeForSchema(subschema))
+		generateGoDeprecation(subschema, propertyName, buffer)
+		buffer.Printf("\nfunc (this *%s) Set%s(value %s) (error) {", schema.GetTitle(), propertyName, GenerateGoTypeForSchema(subschema))
 		buffer.AddIndentation(1)
 
-		switch subschema.GetSchemaType() {
-		case SCHEMATYPE_STRING:
-			generateGoStringSetter(subschema.(*StringSchema), buffer)
-		case SCHEMATYPE_NUMBER:
-			generateGoNumericSetter(subschema.(*NumberSchema), buffer)
-		case SCHEMATYPE_INTEGER:
-			generateGoNumericSetter(subschema.(*IntegerSchema), buffer)
-		case SCHEMATYPE_ARRAY:
-			generateGoArraySetter(subschema.(*ArraySchema), buffer)
+		// only a setter can be given a nil value to check; everything else checks values once it knows they're there.
+		if subschema.HasConstraints() && subschema.GetSchemaType() == SCHEMATYPE_INTEGER && isGoNilable(subschema) {
+			generateGoNilCheck(buffer)
 		}
 
+		generateGoChecks(subschema, failWithStatement, buffer)
+
 		buffer.Printf("\nthis.%s = value\nreturn nil", propertyName)
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
@@ -142,22 +268,21 @@ func generateGoFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
 	Any properties which are both 'required' and have constraints
 	will have their setters used, instead of setting the field directly.
 */
-func generateGoConstructor(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateGoConstructor(model *ModelType, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var subschema TypeSchema
 	var ret bytes.Buffer
-	var parameters, parameterNames []string
-	var title string
+	var parameters []string
+	var propertyName, title string
 
-	for _, propertyName := range schema.RequiredProperties {
+	schema = model.Schema
 
-		subschema = schema.Properties[propertyName]
-		parameterNames = append(parameterNames, propertyName)
+	for _, field := range model.ConstructorFields {
 
-		propertyName = getAppropriateGoCase(schema, propertyName)
-		ret.WriteString(propertyName)
+		ret.WriteString(getAppropriateGoCase(schema, field.Name))
 		ret.WriteString(" ")
-		ret.WriteString(GenerateGoTypeForSchema(subschema))
+		ret.WriteString(GenerateGoTypeForSchema(field.Schema))
 
 		parameters = append(parameters, ret.String())
 		ret.Reset()
@@ -173,10 +298,28 @@ func generateGoConstructor(schema *ObjectSchema, buffer *BufferedFormatString) {
 	// body
 	buffer.Printf("\nret := new(%s)\n", title)
 
-	for _, propertyName := range parameterNames {
+	for _, field := range model.GetConstFields() {
+
+		subschema = field.Schema
+		propertyName = getAppropriateGoCase(schema, field.Name)
+
+		if subschema.GetSchemaType() == SCHEMATYPE_INTEGER {
+			buffer.Printf("\nret.%s = %s", propertyName, getGoIntegerLiteral(subschema.(*IntegerSchema), field.Const))
+			continue
+		}
+
+		if isDecimal(subschema) {
+			buffer.Printf("\nret.%s = json.Number(\"%s\")", propertyName, field.Const)
+			continue
+		}
+
+		buffer.Printf("\nret.%s = %s", propertyName, getConstLiteral(field.Const, "true", "false"))
+	}
+
+	for _, field := range model.ConstructorFields {
 
-		subschema = schema.Properties[propertyName]
-		propertyName = getAppropriateGoCase(schema, propertyName)
+		subschema = field.Schema
+		propertyName = getAppropriateGoCase(schema, field.Name)
 
 		if subschema.HasConstraints() {
 
@@ -202,11 +345,10 @@ func generateGoConstructor(schema *ObjectSchema, buffer *BufferedFormatString) {
 	Numeric schemas could either be NumberSchema or IntegerSchema,
 	since they share the same constraint set, this works on either.
 */
-func generateGoNumericSetter(schema NumericSchemaType, buffer *BufferedFormatString) {
+func generateGoNumericSetter(schema NumericSchemaType, fail checkFailure, buffer *BufferedFormatString) {
 
-	var minimum, maximum, multiple interface{}
-	var formatString string
-	var comparator string
+	var enum, multiple *ModelRule
+	var formatString, description string
 
 	if !schema.HasConstraints() {
 		return
@@ -214,182 +356,896 @@ func generateGoNumericSetter(schema NumericSchemaType, buffer *BufferedFormatStr
 
 	formatString = schema.GetConstraintFormat()
 
-	if schema.HasEnum() {
-		generateGoEnumForSchema(schema, buffer, schema.GetEnum(), "", "")
+	generateGoNotCheck(schema.(TypeSchema), fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateGoEnumForSchema(schema, buffer, enum, "", "", fail)
+	}
+
+	for _, rule := range filterModelRules(GetModelRules(schema.(TypeSchema)), MODELRULE_RANGE, MODELSUBJECT_VALUE) {
+		generateGoRangeCheck(rule, "value", fmt.Sprintf(formatString, rule.Value), fail, buffer)
 	}
 
-	if schema.HasMinimum() {
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
 
-		if schema.IsExclusiveMinimum() {
-			comparator = "<="
+		if schema.GetSchemaType() == SCHEMATYPE_NUMBER {
+			buffer.Printf("\nif(math.Mod(value, %f) != 0) {", multiple.Value)
 		} else {
-			comparator = "<"
+			buffer.Printf("\nif(value %% %d != 0) {", multiple.Value)
 		}
 
-		minimum = schema.GetMinimum()
-
-		buffer.Printf("\nif(value %s "+formatString+") {", comparator, minimum)
 		buffer.AddIndentation(1)
 
-		buffer.Printf("\nreturn errors.New(\"Minimum value of '"+formatString+"' not met\")", minimum)
+		description = fmt.Sprintf("is not a multiple of '"+formatString+"'", multiple.Value)
+		buffer.Printf("\n%s", fail(multiple.Keyword, description, fmt.Sprintf("return errors.New(\"Value %s\")", description)))
 
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
 	}
+}
+
+/*
+	Generates a 'setter' for the given arbitrary-precision integer schema.
+	*big.Int can't use the usual operators, so every comparison is made with Cmp().
+*/
+func generateGoBigIntegerSetter(schema *IntegerSchema, fail checkFailure, buffer *BufferedFormatString) {
 
-	if schema.HasMaximum() {
+	var enum, multiple *ModelRule
 
-		if schema.IsExclusiveMaximum() {
-			comparator = ">="
-		} else {
-			comparator = ">"
+	if !schema.HasConstraints() {
+		return
+	}
+
+	generateGoNotCheck(schema, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		buffer.Print("\nvalidValues := []*big.Int{")
+		for i, enumValue := range enum.Value.([]interface{}) {
+
+			if i > 0 {
+				buffer.Print(",")
+			}
+			buffer.Print(getGoIntegerLiteral(schema, enumValue))
 		}
+		buffer.Print("}\n")
 
-		maximum = schema.GetMaximum()
+		buffer.Print("\nisValid := false")
+		buffer.Print("\nfor _, validValue := range validValues {")
+		buffer.AddIndentation(1)
+		buffer.Print("\nif(validValue.Cmp(value) == 0){")
+		buffer.AddIndentation(1)
+		buffer.Print("\nisValid = true\nbreak")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
 
-		buffer.Printf("\nif(value %s "+formatString+") {", comparator, maximum)
+		buffer.Print("\nif(!isValid){")
 		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(enum.Keyword, "was not found in list of acceptable values", "return errors.New(\"Given value was not found in list of acceptable values\")"))
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	// Cmp() is negative, zero or positive, so it compares to zero the same way value compares to the bound.
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, MODELSUBJECT_VALUE) {
+		generateGoRangeCheck(rule, fmt.Sprintf("value.Cmp(%s)", getGoIntegerLiteral(schema, rule.Value)), "0", fail, buffer)
+	}
 
-		buffer.Printf("\nreturn errors.New(\"Maximum value of '"+formatString+"' not met\")", maximum)
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
 
+		buffer.Printf("\nif(new(big.Int).Mod(value, %s).Sign() != 0) {", getGoIntegerLiteral(schema, multiple.Value))
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(multiple.Keyword, fmt.Sprintf("is not a multiple of '%v'", multiple.Value), fmt.Sprintf("return errors.New(\"Value is not a multiple of '%v'\")", multiple.Value)))
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
 	}
+}
 
-	if schema.HasMultiple() {
+/*
+	Generates a 'setter' for the given decimal schema.
+	Decimals are stored as json.Number (so that they're never rounded),
+	and are parsed into a big.Rat in order to check them exactly.
+*/
+func generateGoDecimalSetter(schema *NumberSchema, fail checkFailure, buffer *BufferedFormatString) {
 
-		multiple = schema.GetMultiple()
+	var enum, multiple *ModelRule
 
-		if schema.GetSchemaType() == SCHEMATYPE_NUMBER {
-			buffer.Printf("\nif(math.Mod(value, %f) != 0) {", multiple)
-		} else {
-			buffer.Printf("\nif(value %% %d != 0) {", multiple)
+	if !schema.HasConstraints() {
+		return
+	}
+
+	generateGoNotCheck(schema, fail, buffer)
+
+	buffer.Print("\ndecimalValue, isDecimal := new(big.Rat).SetString(string(value))")
+	buffer.Print("\nif(!isDecimal) {")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail("type", "is not a valid decimal", "return errors.New(\"Value is not a valid decimal\")"))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	// a value which isn't a decimal can't be checked any further.
+	buffer.Print(" else {")
+	buffer.AddIndentation(1)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		buffer.Print("\nvalidValues := []*big.Rat{")
+		for i, enumValue := range enum.Value.([]interface{}) {
+
+			if i > 0 {
+				buffer.Print(",")
+			}
+			buffer.Print(getGoDecimalLiteral(enumValue))
 		}
+		buffer.Print("}\n")
 
+		buffer.Print("\nisValid := false")
+		buffer.Print("\nfor _, validValue := range validValues {")
+		buffer.AddIndentation(1)
+		buffer.Print("\nif(validValue.Cmp(decimalValue) == 0){")
 		buffer.AddIndentation(1)
+		buffer.Print("\nisValid = true\nbreak")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
 
-		buffer.Printf("\nreturn errors.New(\"Value is not a multiple of '"+formatString+"'\")", multiple)
+		buffer.Print("\nif(!isValid){")
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(enum.Keyword, "was not found in list of acceptable values", "return errors.New(\"Given value was not found in list of acceptable values\")"))
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
 
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, MODELSUBJECT_VALUE) {
+		generateGoRangeCheck(rule, fmt.Sprintf("decimalValue.Cmp(%s)", getGoDecimalLiteral(rule.Value)), "0", fail, buffer)
+	}
+
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
+
+		buffer.Printf("\nif(!new(big.Rat).Quo(decimalValue, %s).IsInt()) {", getGoDecimalLiteral(multiple.Value))
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(multiple.Keyword, fmt.Sprintf("is not a multiple of '%s'", multiple.Value), fmt.Sprintf("return errors.New(\"Value is not a multiple of '%s'\")", multiple.Value)))
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
 	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Returns a Go expression which creates a *big.Rat for the given decimal [value].
+*/
+func getGoDecimalLiteral(value interface{}) string {
+	return fmt.Sprintf("func() *big.Rat { ret, _ := new(big.Rat).SetString(\"%s\"); return ret }()", value)
+}
+
+/*
+	Returns a Go expression for the given integer [value], appropriate for the width of the given schema.
+	Arbitrary-precision values which don't fit in an int64 are parsed from their decimal string.
+*/
+func getGoIntegerLiteral(schema *IntegerSchema, value interface{}) string {
+
+	var bigValue *big.Int
+
+	if !schema.IsBig() {
+		return getIntegerString(value)
+	}
+
+	bigValue, _ = new(big.Int).SetString(getIntegerString(value), 10)
+
+	if bigValue.IsInt64() {
+		return fmt.Sprintf("big.NewInt(%s)", bigValue.String())
+	}
+
+	return fmt.Sprintf("func() *big.Int { ret, _ := new(big.Int).SetString(\"%s\", 10); return ret }()", bigValue.String())
 }
 
 /*
 	Generates a 'setter' function for the given string schema.
 	Generates code which validates all schema constraints before setting.
 */
-func generateGoStringSetter(schema *StringSchema, buffer *BufferedFormatString) {
+func generateGoStringSetter(schema *StringSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum, pattern *ModelRule
+
+	generateGoNotCheck(schema, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateGoEnumForSchema(schema, buffer, enum, "\"", "\"", fail)
+	}
+
+	generateGoRangeChecks(schema, MODELSUBJECT_LENGTH, "len(value)", fail, buffer)
+	generateGoRangeChecks(schema, MODELSUBJECT_BYTE_LENGTH, "len([]byte(value))", fail, buffer)
+
+	pattern = getModelRule(schema, MODELRULE_PATTERN)
+	if pattern != nil {
+
+		buffer.Printf("\nmatched, err := regexp.Match(\"%s\", []byte(value))", sanitizeQuotedString(pattern.Value.(string)))
+		buffer.Printf("\nif(err != nil){%s}", fail(pattern.Keyword, "could not be matched against its pattern", "return err"))
+		buffer.Printf("\nif(!matched) {")
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s", fail(pattern.Keyword, fmt.Sprintf("did not match pattern '%s'", pattern.Value), fmt.Sprintf("return errors.New(\"Value did not match pattern '%s'\")", pattern.Value)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Generates 'setter' code to validate the given boolean schema's constraints.
+	Booleans only ever have a "const" or "not" constraint.
+*/
+func generateGoBooleanSetter(schema *BooleanSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum *ModelRule
+	var value interface{}
+
+	generateGoNotCheck(schema, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		value = enum.Value.([]interface{})[0]
+
+		buffer.Printf("\nif(value != %v) {", value)
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(enum.Keyword, fmt.Sprintf("must be '%v'", value), fmt.Sprintf("return errors.New(\"Value must be '%v'\")", value)))
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Generates code which returns an error if the value matches the "not" subschema of the given [schema].
+	The subschema's checks are generated inside a closure, and the value is only valid if that closure fails.
+*/
+func generateGoNotCheck(schema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var not *ModelRule
+
+	not = getModelRule(schema, MODELRULE_NOT)
+	if not == nil {
+		return
+	}
+
+	buffer.Printf("\nif func(value %s) error {", GenerateGoTypeForSchema(not.Value))
+	buffer.AddIndentation(1)
+
+	generateGoChecks(not.Value.(TypeSchema), failWithStatement, buffer)
+
+	buffer.Print("\nreturn nil")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}(value) == nil {")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(not.Keyword, not.Message, fmt.Sprintf("return errors.New(\"Value %s\")", not.Message)))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates a check which returns an error if 'value' is nil.
+	Arbitrary-precision integers can't be compared at all without a value.
+*/
+func generateGoNilCheck(buffer *BufferedFormatString) {
+
+	buffer.Print("\nif(value == nil) {")
+	buffer.AddIndentation(1)
+	buffer.Print("\nreturn errors.New(\"Value cannot be nil\")")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates all the checks appropriate for the type of the given [subschema],
+	each of which reports (with [fail]) if 'value' violates a constraint.
+*/
+func generateGoChecks(subschema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	if hasTypeOverride(subschema, "go") {
+		return
+	}
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING:
+		generateGoStringSetter(subschema.(*StringSchema), fail, buffer)
+	case SCHEMATYPE_NUMBER:
+
+		if subschema.(*NumberSchema).IsDecimal() {
+			generateGoDecimalSetter(subschema.(*NumberSchema), fail, buffer)
+		} else {
+			generateGoNumericSetter(subschema.(*NumberSchema), fail, buffer)
+		}
+	case SCHEMATYPE_INTEGER:
+
+		if subschema.(*IntegerSchema).IsBig() {
+			generateGoBigIntegerSetter(subschema.(*IntegerSchema), fail, buffer)
+		} else {
+			generateGoNumericSetter(subschema.(*IntegerSchema), fail, buffer)
+		}
+	case SCHEMATYPE_BOOLEAN:
+		generateGoBooleanSetter(subschema.(*BooleanSchema), fail, buffer)
+	case SCHEMATYPE_ARRAY:
+		generateGoArraySetter(subschema.(*ArraySchema), fail, buffer)
+	}
+}
+
+/*
+	Generates 'setter' code to validate the given array schema's constraints,
+	then set the owner object's value to the one passed in.
+*/
+func generateGoArraySetter(schema *ArraySchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var rules []*ModelRule
+
+	rules = GetModelRules(schema)
+
+	if len(filterModelRules(rules, MODELRULE_RANGE, MODELSUBJECT_ITEMS)) > 0 {
+
+		buffer.Print("\nlength := len(value)\n")
+		generateGoRangeChecks(schema, MODELSUBJECT_ITEMS, "length", fail, buffer)
+	}
+
+	for _, rule := range filterModelRules(rules, MODELRULE_UNIQUE, MODELSUBJECT_ITEMS) {
+		generateGoUniqueCheck(rule, fail, buffer)
+	}
+}
+
+/*
+	Generates a check which reports (with [fail]) if any two items of 'value' are equal, violating the given unique [rule].
+*/
+func generateGoUniqueCheck(rule *ModelRule, fail checkFailure, buffer *BufferedFormatString) {
+
+	buffer.Print("\nif func() bool {")
+	buffer.AddIndentation(1)
+	buffer.Print("\nfor i := range value {")
+	buffer.AddIndentation(1)
+	buffer.Print("\nfor j := i + 1; j < len(value); j++ {")
+	buffer.AddIndentation(1)
+	buffer.Print("\nif(reflect.DeepEqual(value[i], value[j])) {")
+	buffer.AddIndentation(1)
+	buffer.Print("\nreturn true")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.Print("\nreturn false")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}() {")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(rule.Keyword, rule.Message, fmt.Sprintf("return errors.New(\"Value %s\")", rule.Message)))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates a check for every range rule of the given [schema] on the given (counted) [subject], comparing [reference] to each bound.
+*/
+func generateGoRangeChecks(schema TypeSchema, subject ModelSubject, reference string, fail checkFailure, buffer *BufferedFormatString) {
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, subject) {
+		generateGoRangeCheck(rule, reference, fmt.Sprintf("%d", rule.Value), fail, buffer)
+	}
+}
+
+/*
+	Generates a check which reports (with [fail]) if [reference] violates the given range [rule], with the bound written as [value].
+*/
+func generateGoRangeCheck(rule *ModelRule, reference string, value string, fail checkFailure, buffer *BufferedFormatString) {
+
+	var description string
+
+	description = fmt.Sprintf("%s (%v)", rule.Message, rule.Value)
+
+	buffer.Printf("\nif(%s %s %s) {", reference, rule.Violation, value)
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(rule.Keyword, description, fmt.Sprintf("return errors.New(\"Value %s\")", description)))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates a "collectConditionalViolations" method for the given schema, which checks constraints that span multiple properties;
+	if/then/else, dependentRequired, and dependentSchemas. CollectViolations appends whatever it finds.
+	The "if" schema is generated as an unexported method which returns the first violation found, since it only decides
+	which of "then" or "else" applies. Each other conditional schema is generated as its own method,
+	which appends a violation for each field (beneath the given prefix) which doesn't meet it.
+
+	Go fields always have a value, so a property counts as "present" when it is not the zero value of its type.
+*/
+func generateGoConditions(schema *ObjectSchema, buffer *BufferedFormatString) {
 
-	var cutoff int
+	var title, presence, dependencyPresence string
 
-	if schema.Enum != nil {
-		generateGoEnumForSchema(schema, buffer, schema.GetEnum(), "\"", "\"")
+	if !schema.HasConditions() {
+		return
 	}
 
-	if schema.MinLength != nil {
+	title = ToCamelCase(schema.Title)
+
+	buffer.Printf("\n/*\nAppends a description of each constraint of this %s which spans more than one field, and which it violates, to [violations].\n*/", title)
+	buffer.Printf("\nfunc (this *%s) collectConditionalViolations(prefix string, violations []string) []string {\n", title)
+	buffer.AddIndentation(1)
 
-		cutoff = *schema.MinLength
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		if schema.Then != nil {
+
+			buffer.Print("\nif(this.validateIf() == nil) {")
+			buffer.AddIndentation(1)
+			buffer.Print("\nviolations = this.collectThenConditionViolations(prefix, violations)")
+			buffer.AddIndentation(-1)
+
+			if schema.Else != nil {
+				buffer.Print("\n} else {")
+				buffer.AddIndentation(1)
+				buffer.Print("\nviolations = this.collectElseConditionViolations(prefix, violations)")
+				buffer.AddIndentation(-1)
+			}
+		} else {
+			buffer.Print("\nif(this.validateIf() != nil) {")
+			buffer.AddIndentation(1)
+			buffer.Print("\nviolations = this.collectElseConditionViolations(prefix, violations)")
+			buffer.AddIndentation(-1)
+		}
+
+		buffer.Print("\n}\n")
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		presence = getGoPresenceCheck(schema.Properties[propertyName], "this."+getAppropriateGoCase(schema, propertyName))
+
+		// properties which always have a value get an unconditional block.
+		if len(presence) > 0 {
+			buffer.Printf("\nif(%s) {", presence)
+		} else {
+			buffer.Print("\n{")
+		}
 
-		buffer.Printf("\nif(len(value) < %d) {", cutoff)
 		buffer.AddIndentation(1)
-		buffer.Printf("\nreturn errors.New(\"Value is shorter than minimum length of %d\")", cutoff)
+
+		for _, dependency := range schema.DependentRequired[propertyName] {
+
+			dependencyPresence = getGoPresenceCheck(schema.Properties[dependency], "this."+getAppropriateGoCase(schema, dependency))
+			if len(dependencyPresence) == 0 {
+				continue
+			}
+
+			buffer.Printf("\nif(!(%s)) {", dependencyPresence)
+			buffer.AddIndentation(1)
+			buffer.Printf("\nviolations = append(violations, prefix + \"%s\")", getDependentRequiredViolationText(dependency, propertyName))
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+
+		if _, found := schema.DependentSchemas[propertyName]; found {
+			buffer.Printf("\nviolations = this.collectDependent%sConditionViolations(prefix, violations)", ToStrictCamelCase(propertyName))
+		}
+
 		buffer.AddIndentation(-1)
-		buffer.Printf("\n}\n")
+		buffer.Print("\n}\n")
+	}
+
+	buffer.Print("\nreturn violations")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		generateGoCondition(schema, schema.If, "If", false, buffer)
+
+		if schema.Then != nil {
+			generateGoCondition(schema, schema.Then, "Then", true, buffer)
+		}
+		if schema.Else != nil {
+			generateGoCondition(schema, schema.Else, "Else", true, buffer)
+		}
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		if condition, found := schema.DependentSchemas[propertyName]; found {
+			generateGoCondition(schema, condition, "Dependent"+ToStrictCamelCase(propertyName), true, buffer)
+		}
+	}
+}
+
+/*
+	Generates an unexported method which checks the given [condition] against an instance of [schema].
+	If [collect]ing, the method appends a violation to the given list for each field which doesn't meet the condition
+	(described with the given prefix before its name); otherwise it returns an error for the first.
+	Constraints on properties are checked by reusing the setter checks. Properties which aren't present are not checked.
+*/
+func generateGoCondition(schema *ObjectSchema, condition *ConditionalSchema, name string, collect bool, buffer *BufferedFormatString) {
+
+	var subschema TypeSchema
+	var failure checkFailure
+	var presence string
+
+	if collect {
+		buffer.Printf("\nfunc (this *%s) collect%sConditionViolations(prefix string, violations []string) []string {\n", ToCamelCase(schema.Title), name)
+	} else {
+		buffer.Printf("\nfunc (this *%s) validate%s() error {\n", ToCamelCase(schema.Title), name)
 	}
+	buffer.AddIndentation(1)
 
-	if schema.MaxLength != nil {
+	for _, propertyName := range condition.RequiredProperties {
 
-		cutoff = *schema.MaxLength
+		presence = getGoPresenceCheck(schema.Properties[propertyName], "this."+getAppropriateGoCase(schema, propertyName))
+		if len(presence) == 0 {
+			continue
+		}
 
-		buffer.Printf("\nif(len(value) > %d) {", cutoff)
+		buffer.Printf("\nif(!(%s)) {", presence)
 		buffer.AddIndentation(1)
-		buffer.Printf("\nreturn errors.New(\"Value is greater than maximum length of %d\")", cutoff)
+
+		if collect {
+			buffer.Printf("\nviolations = append(violations, prefix + \"%s: required: is required\")", sanitizeQuotedString(propertyName))
+		} else {
+			buffer.Printf("\nreturn errors.New(\"Property '%s' is required\")", propertyName)
+		}
+
 		buffer.AddIndentation(-1)
-		buffer.Printf("\n}\n")
+		buffer.Print("\n}\n")
 	}
 
-	if schema.MaxByteLength != nil {
+	for _, propertyName := range condition.GetOrderedPropertyNames() {
+
+		subschema = condition.Properties[propertyName]
+		if !subschema.HasConstraints() || hasTypeOverride(schema.Properties[propertyName], "go") {
+			continue
+		}
+
+		presence = getGoPresenceCheck(schema.Properties[propertyName], "this."+getAppropriateGoCase(schema, propertyName))
+
+		if collect {
 
-		cutoff = *schema.MaxByteLength
+			failure = chooseViolation(schema.Properties[propertyName], getGoViolation, getGoWriteOnlyViolation)
+
+			buffer.Print("\n{")
+			buffer.AddIndentation(1)
+			buffer.Printf("\npath := prefix + \"%s\"", sanitizeQuotedString(propertyName))
+			buffer.Printf("\nvalue := this.%s", getAppropriateGoCase(schema, propertyName))
 
-		buffer.Printf("\nif(len([]byte(value)) > %d) {", cutoff)
+			if len(presence) > 0 {
+				buffer.Printf("\nif(%s) {", getGoPresenceCheck(schema.Properties[propertyName], "value"))
+				buffer.AddIndentation(1)
+			}
+
+			generateGoChecks(subschema, failure, buffer)
+
+			if len(presence) > 0 {
+				buffer.AddIndentation(-1)
+				buffer.Print("\n}")
+			}
+
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}\n")
+			continue
+		}
+
+		if len(presence) > 0 {
+			buffer.Printf("\nif(%s) {", presence)
+			buffer.AddIndentation(1)
+		}
+
+		buffer.Printf("\nif err := func(value %s) error {", GenerateGoTypeForSchema(subschema))
 		buffer.AddIndentation(1)
-		buffer.Printf("\nreturn errors.New(\"Value's byte length is longer than maximum length of %d\")", cutoff)
+
+		generateGoChecks(subschema, failWithStatement, buffer)
+
+		buffer.Print("\nreturn nil")
 		buffer.AddIndentation(-1)
-		buffer.Printf("\n}\n")
+		buffer.Printf("\n}(this.%s); err != nil {", getAppropriateGoCase(schema, propertyName))
+		buffer.AddIndentation(1)
+		buffer.Print("\nreturn err")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}")
+
+		if len(presence) > 0 {
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+		buffer.Print("\n")
+	}
+
+	if collect {
+		buffer.Print("\nreturn violations")
+	} else {
+		buffer.Print("\nreturn nil")
 	}
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
 
-	if schema.MinByteLength != nil {
+/*
+	Generates a "Validate" method for the given schema, which checks every constraint of every field (and of every object they hold),
+	and returns an error which lists every violation, rather than stopping at the first.
+	The work is done by "CollectViolations", which objects holding this one call with their own path.
+*/
+func generateGoValidation(model *ModelType, buffer *BufferedFormatString) {
+
+	var schema *ObjectSchema
+	var fields []*ModelField
+	var title, reference string
+
+	schema = model.Schema
+	title = ToCamelCase(schema.Title)
+	fields = getValidatedFields(model, "go")
+
+	buffer.Printf("\n/*\nChecks every constraint of this %s, and of every object it holds,", title)
+	buffer.Print("\nand returns an error which lists every violation (or nil, if there are none).\n*/")
+	buffer.Printf("\nfunc (this *%s) Validate() error {\n", title)
+	buffer.AddIndentation(1)
+
+	buffer.Print("\nviolations := this.CollectViolations(\"\", nil)")
+	buffer.Print("\nif(len(violations) > 0) {")
+	buffer.AddIndentation(1)
+	buffer.Print("\nreturn errors.New(strings.Join(violations, \"\\n\"))")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.Print("\nreturn nil")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
 
-		cutoff = *schema.MinByteLength
+	buffer.Printf("\n/*\nAppends a description of every constraint this %s violates to [violations], and returns them.", title)
+	buffer.Print("\nEach is described with the path of the field which violated it, beneath the given [path] of this object.\n*/")
+	buffer.Printf("\nfunc (this *%s) CollectViolations(path string, violations []string) []string {\n", title)
+	buffer.AddIndentation(1)
 
-		buffer.Printf("\nif(len([]byte(value)) < %d) {", cutoff)
+	if len(fields) > 0 || schema.HasConditions() {
+		buffer.Print("\nprefix := path")
+		buffer.Print("\nif(prefix != \"\") {")
 		buffer.AddIndentation(1)
-		buffer.Printf("\nreturn errors.New(\"Value's byte length is shorter than minimum length of %d\")", cutoff)
+		buffer.Print("\nprefix += \".\"")
 		buffer.AddIndentation(-1)
-		buffer.Printf("\n}\n")
+		buffer.Print("\n}\n")
 	}
 
-	if schema.Pattern != nil {
+	for _, field := range fields {
 
-		buffer.Printf("\nmatched, err := regexp.Match(\"%s\", []byte(value))", sanitizeQuotedString(*schema.Pattern))
-		buffer.Printf("\nif(err != nil){return err}")
-		buffer.Printf("\nif(!matched) {")
+		reference = "this." + getAppropriateGoCase(schema, field.Name)
+
+		buffer.Print("\n{")
 		buffer.AddIndentation(1)
+		buffer.Printf("\npath := prefix + \"%s\"", sanitizeQuotedString(field.Name))
+		buffer.Printf("\nvalue := %s", reference)
 
-		buffer.Printf("\nreturn errors.New(\"Value did not match regex '%s'\")", *schema.Pattern)
+		generateGoFieldValidation(field.Schema, field.Required, buffer)
 
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
 	}
+
+	if schema.HasConditions() {
+		buffer.Print("\nviolations = this.collectConditionalViolations(prefix, violations)\n")
+	}
+
+	buffer.Print("\nreturn violations")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
 }
 
 /*
-	Generates 'setter' code to validate the given array schema's constraints,
-	then set the owner object's value to the one passed in.
+	Generates the checks of one field, whose value is in 'value' and whose path is in 'path'.
+	A field which is [required] is always checked (and being nil is a violation of its own), so a required field
+	left at its zero value is a violation if that zero value breaks a constraint.
+	An optional field can't be told apart from one that was never set while it's nil or zero, so it's only checked once it has a value.
 */
-func generateGoArraySetter(schema *ArraySchema, buffer *BufferedFormatString) {
+func generateGoFieldValidation(subschema TypeSchema, required bool, buffer *BufferedFormatString) {
 
-	if !schema.HasConstraints() {
+	var failure checkFailure
+	var presence string
+
+	failure = chooseViolation(subschema, getGoViolation, getGoWriteOnlyViolation)
+	presence = getGoPresenceCheck(subschema, "value")
+
+	if required && isGoNilable(subschema) {
+
+		buffer.Print("\nif(value == nil) {")
+		buffer.AddIndentation(1)
+		buffer.Print("\nviolations = append(violations, path + \": required: is required\")")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n} else {")
+	} else if !required && len(presence) > 0 {
+		buffer.Printf("\nif(%s) {", presence)
+	} else {
+		generateGoValueValidation(subschema, failure, buffer)
 		return
 	}
 
-	buffer.Print("\nlength := len(value)\n")
+	buffer.AddIndentation(1)
+	generateGoValueValidation(subschema, failure, buffer)
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+}
+
+/*
+	Generates the checks of the given [subschema] on 'value' (whose path is in 'path'), and of anything it holds.
+*/
+func generateGoValueValidation(subschema TypeSchema, failure checkFailure, buffer *BufferedFormatString) {
+
+	var items TypeSchema
 
-	if schema.MinItems != nil {
+	generateGoChecks(subschema, failure, buffer)
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_OBJECT:
+		buffer.Print("\nviolations = value.CollectViolations(path, violations)")
+
+	case SCHEMATYPE_ARRAY:
+
+		items = subschema.(*ArraySchema).Items
+		if !needsValidation(items, "go") {
+			return
+		}
 
-		buffer.Printf("\nif(length < %d) {", *schema.MinItems)
+		// each item shadows the array's value and path with its own.
+		buffer.Print("\nfor i, value := range value {")
 		buffer.AddIndentation(1)
+		buffer.Print("\npath := fmt.Sprintf(\"%s[%d]\", path, i)")
 
-		buffer.Printf("\nreturn errors.New(\"Minimum number of elements '%d' not present\")", *schema.MinItems)
+		// items which are nil hold nothing to check, but every other item (even a zero value) is checked.
+		if isGoNilable(items) {
+
+			buffer.Print("\nif(value != nil) {")
+			buffer.AddIndentation(1)
+			generateGoValueValidation(items, failure, buffer)
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		} else {
+			generateGoValueValidation(items, failure, buffer)
+		}
 
 		buffer.AddIndentation(-1)
-		buffer.Printf("\n}\n")
+		buffer.Print("\n}\n")
 	}
+}
 
-	if schema.MaxItems != nil {
+/*
+	Returns true if the Go type of the given [subschema] can be nil.
+*/
+func isGoNilable(subschema TypeSchema) bool {
+	return strings.HasSuffix(getGoPresenceCheck(subschema, "value"), "!= nil")
+}
 
-		buffer.Printf("\nif(length > %d) {", *schema.MaxItems)
-		buffer.AddIndentation(1)
+/*
+	A checkFailure which appends a description of the violation (with the path in 'path', and the offending 'value') to 'violations'.
+*/
+func getGoViolation(keyword string, description string, statement string) string {
 
-		buffer.Printf("return errors.New(\"Maximum number of elements '%d' not present\")", *schema.MaxItems)
+	var escaper *strings.Replacer
 
-		buffer.AddIndentation(-1)
-		buffer.Printf("\n}\n")
+	escaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "%", "%%")
+	return fmt.Sprintf("violations = append(violations, fmt.Sprintf(\"%%s: %s: %s, value: %%v\", path, value))", keyword, escaper.Replace(description))
+}
+
+/*
+	A checkFailure like getGoViolation, for writeOnly values, which leaves the value out of the description.
+*/
+func getGoWriteOnlyViolation(keyword string, description string, statement string) string {
+
+	var escaper *strings.Replacer
+
+	escaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "%", "%%")
+	return fmt.Sprintf("violations = append(violations, fmt.Sprintf(\"%%s: %s: %s\", path))", keyword, escaper.Replace(description))
+}
+
+/*
+	Returns true if the violations of the given [schema] are described with fmt; which every constraint check does
+	(of a field, an item, or a conditional schema other than "if"), as does the path of each item of a checked array.
+	Objects are only checked by their own CollectViolations, and a missing field only needs its path.
+*/
+func goViolationsNeedFmt(schema *ObjectSchema) bool {
+
+	var subschema TypeSchema
+	var conditions []*ConditionalSchema
+
+	for _, field := range getValidatedFields(NewModelType(schema), "go") {
+
+		subschema = field.Schema
+		if subschema.HasConstraints() || subschema.GetSchemaType() == SCHEMATYPE_ARRAY {
+			return true
+		}
+	}
+
+	if schema.If != nil {
+		conditions = append(conditions, schema.Then, schema.Else)
+	}
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+		conditions = append(conditions, schema.DependentSchemas[propertyName])
+	}
+
+	for _, condition := range conditions {
+
+		if condition == nil {
+			continue
+		}
+
+		for _, propertyName := range condition.GetOrderedPropertyNames() {
+
+			subschema = schema.Properties[propertyName]
+			if subschema != nil && !hasTypeOverride(subschema, "go") && condition.Properties[propertyName].HasConstraints() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func generateGoErrorReturn(buffer *BufferedFormatString) {
+
+	buffer.Print("\nif(err != nil) {")
+	buffer.AddIndentation(1)
+	buffer.Print("\nreturn err")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Returns an expression which is true if the field at [reference] has been given a value,
+	or an empty string if that can't be known (as with booleans, or overridden types).
+*/
+func getGoPresenceCheck(subschema TypeSchema, reference string) string {
+
+	if len(getExtensionOverride(subschema, "go", EXTENSION_TYPE)) > 0 {
+		return ""
+	}
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING:
+
+		if subschema.(*StringSchema).IsBinary() {
+			return "len(" + reference + ") != 0"
+		}
+		return reference + " != \"\""
+	case SCHEMATYPE_INTEGER:
+
+		if subschema.(*IntegerSchema).IsBig() {
+			return reference + " != nil"
+		}
+		return reference + " != 0"
+	case SCHEMATYPE_NUMBER:
+
+		if subschema.(*NumberSchema).IsDecimal() {
+			return reference + " != \"\""
+		}
+		return reference + " != 0"
+	case SCHEMATYPE_OBJECT:
+		fallthrough
+	case SCHEMATYPE_ARRAY:
+		return reference + " != nil"
 	}
+
+	return ""
 }
 
 /*
 	Convenience method to generate an enum constraint check for the given schema and
-	its provided enum values.
+	its enum [rule].
 	Generates an inline set of constants, each value of which is prefixed and postfixed accordingly,
 	then generates code to check against those constants.
 */
-func generateGoEnumForSchema(schema interface{}, buffer *BufferedFormatString, enumValues []interface{}, prefix string, postfix string) {
+func generateGoEnumForSchema(schema interface{}, buffer *BufferedFormatString, rule *ModelRule, prefix string, postfix string, fail checkFailure) {
 
+	var enumValues []interface{}
 	var length int
 
+	enumValues = rule.Value.([]interface{})
 	length = len(enumValues)
 
 	if length <= 0 {
@@ -413,14 +1269,13 @@ func generateGoEnumForSchema(schema interface{}, buffer *BufferedFormatString, e
 	buffer.AddIndentation(1)
 	buffer.Printf("\nisValid = true\nbreak")
 	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
 	buffer.Print("\n}\n")
 
 	buffer.Print("\nif(!isValid){")
 	buffer.AddIndentation(1)
-	buffer.Print("\nreturn errors.New(\"Given value was not found in list of acceptable values\")")
-	buffer.AddIndentation(-1)
-	buffer.Print("\n}\n")
-
+	buffer.Printf("\n%s", fail(rule.Keyword, "was not found in list of acceptable values", "return errors.New(\"Given value was not found in list of acceptable values\")"))
 	buffer.AddIndentation(-1)
 	buffer.Print("\n}\n")
 }
@@ -431,14 +1286,41 @@ func generateGoEnumForSchema(schema interface{}, buffer *BufferedFormatString, e
 */
 func GenerateGoTypeForSchema(schema interface{}) string {
 
+	var override string
+
+	// "x-go-type" replaces whatever type would otherwise be used.
+	if typeSchema, isSchema := schema.(TypeSchema); isSchema {
+
+		override = getExtensionOverride(typeSchema, "go", EXTENSION_TYPE)
+		if len(override) > 0 {
+			return override
+		}
+	}
+
 	switch schema.(type) {
 	case *BooleanSchema:
 		return "bool"
 	case *StringSchema:
+
+		// encoding/json already (un)marshals byte slices as base64.
+		if schema.(*StringSchema).IsBinary() {
+			return "[]byte"
+		}
 		return "string"
 	case *IntegerSchema:
+
+		switch schema.(*IntegerSchema).GetWidth() {
+		case INTEGERWIDTH_64:
+			return "int64"
+		case INTEGERWIDTH_BIG:
+			return "*big.Int"
+		}
 		return "int"
 	case *NumberSchema:
+
+		if schema.(*NumberSchema).IsDecimal() {
+			return "json.Number"
+		}
 		return "float64"
 	case *ObjectSchema:
 		return "*" + ToCamelCase(schema.(TypeSchema).GetTitle())
@@ -451,24 +1333,84 @@ func GenerateGoTypeForSchema(schema interface{}) string {
 
 /*
 	Generates a type variable declaration for the given schema and propertyName,
-	using the given casing function to modify the name of the property in the correct places.
+	named [fieldName], with struct tags which use the (cased) property name.
+	Any "x-go-tag" is added to the generated struct tags.
 */
-func generateVariableDeclaration(subschema TypeSchema, buffer *BufferedFormatString, propertyName string, casing func(string) string) {
+func generateVariableDeclaration(subschema TypeSchema, buffer *BufferedFormatString, propertyName string, fieldName string) {
 
-	var casedName string
+	var casedName, extraTags string
 
 	casedName = ToJavaCase(propertyName)
 
+	extraTags = getExtensionOverride(subschema, "go", EXTENSION_TAG)
+	if len(extraTags) > 0 {
+		extraTags = " " + extraTags
+	}
+
+	generateGoDeprecation(subschema, fieldName, buffer)
+
 	// TODO: this means unexported fields will have json deserialization struct tags,
 	// which won't work.
-	buffer.Printf("\n%s %s", casing(propertyName), GenerateGoTypeForSchema(subschema))
-	buffer.Printf(" `json:\"%s\" xml:\"%s\" bson:\"%s\" codec:\"%s\"`", casedName, casedName, casedName, casedName)
+	buffer.Printf("\n%s %s", fieldName, GenerateGoTypeForSchema(subschema))
+	buffer.Printf(" `json:\"%s\" xml:\"%s\" bson:\"%s\" codec:\"%s\"%s`", casedName, casedName, casedName, casedName, extraTags)
+}
+
+/*
+	Generates a MarshalJSON method which leaves out the given schema's writeOnly fields,
+	so that they're accepted by json.Unmarshal, but never serialized back out.
+	Each is shadowed by a nil pointer with the same json name, which "omitempty" skips.
+	The method has a value receiver, so that it's used whether a value or a pointer is marshalled.
+	Other encodings (xml, bson, codec) have no equivalent, and serialize writeOnly fields like any other.
+*/
+func generateGoSerializer(model *ModelType, buffer *BufferedFormatString) {
+
+	var writeOnly []*ModelField
+	var title string
+
+	writeOnly = model.GetWriteOnlyFields()
+	if len(writeOnly) <= 0 {
+		return
+	}
+
+	title = ToCamelCase(model.Name)
+
+	buffer.Printf("\n/*\nSerializes this %s, without its writeOnly fields.\n*/", title)
+	buffer.Printf("\nfunc (this %s) MarshalJSON() ([]byte, error) {", title)
+	buffer.AddIndentation(1)
+
+	// the alias has no methods, so marshalling it doesn't recurse into this one.
+	buffer.Printf("\n\ntype alias %s", title)
+	buffer.Print("\nreturn json.Marshal(struct {")
+	buffer.AddIndentation(1)
+	buffer.Print("\nalias")
+
+	for _, field := range writeOnly {
+		buffer.Printf("\n%s *struct{} `json:\"%s,omitempty\"`", getAppropriateGoCase(model.Schema, field.Name), ToJavaCase(field.Name))
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}{alias: alias(this)})")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates a "Deprecated:" doc comment for the given [name], if the given schema is deprecated.
+*/
+func generateGoDeprecation(subschema TypeSchema, name string, buffer *BufferedFormatString) {
+
+	if !subschema.IsDeprecated() {
+		return
+	}
+
+	buffer.Printf("\n// Deprecated: %s is marked as deprecated by its schema.", name)
 }
 
 /*
 	Determines and returns the appropriate case for the property of schema provided.
 	Only unconstrained fields are exported in Go generated code;
 	if the referenced field is constrained in any way, this generates an unexported field name.
+	A property's "x-go-name" is used in place of its own name.
 */
 func getAppropriateGoCase(schema *ObjectSchema, propertyName string) string {
 
@@ -477,5 +1419,5 @@ func getAppropriateGoCase(schema *ObjectSchema, propertyName string) string {
 			return ToStrictJavaCase(propertyName)
 		}
 	}*/
-	return ToStrictCamelCase(propertyName)
+	return ToStrictCamelCase(getOverriddenName(schema, propertyName, "go"))
 }
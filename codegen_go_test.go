@@ -0,0 +1,254 @@
+package presilo
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+	Validates a freshly constructed object of every type in the conformance corpus.
+	Only required fields are given values, so any violation comes from an optional field which was never set.
+	The "extensions" schema is left out, since its overridden types import packages from outside the standard library.
+*/
+const goNewObjectsAreValid = `
+package main
+
+import (
+	"fmt"
+
+	"check/conformance"
+)
+
+type validated interface {
+	Validate() error
+}
+
+func main() {
+
+	owner, _ := conformance.NewOwner("alice")
+	arrays, _ := conformance.NewArrays()
+	point, _ := conformance.NewPoint(1, 2)
+	booleans, _ := conformance.NewBooleans(true)
+	conditionals, _ := conformance.NewConditionals()
+	integers, _ := conformance.NewIntegers(1)
+	negations, _ := conformance.NewNegations()
+	numbers, _ := conformance.NewNumbers()
+	address, _ := conformance.NewAddress("Springfield")
+	objects, _ := conformance.NewObjects(owner)
+	strs, _ := conformance.NewStrings("bob")
+
+	for _, value := range []validated{arrays, point, booleans, conditionals, integers, negations, numbers, address, objects, owner, strs} {
+
+		err := value.Validate()
+		if err != nil {
+			fmt.Printf("%T: %s\n", value, err.Error())
+		}
+	}
+}
+`
+
+func TestGoNewObjectsAreValid(test *testing.T) {
+
+	var output string
+
+	output = runGoConformance(test, []string{"arrays", "booleans", "conditionals", "integers", "not", "numbers", "objects", "strings"}, goNewObjectsAreValid)
+	if output != "" {
+		test.Errorf("Expected objects with only their required fields set to be valid, but got:\n%s", output)
+	}
+}
+
+/*
+	Marshals an object with writeOnly fields, both by pointer and by value; neither should include them.
+*/
+const goMarshalLeavesOutWriteOnly = `
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"check/conformance"
+)
+
+func main() {
+
+	owner, _ := conformance.NewOwner("alice")
+	objects, _ := conformance.NewObjects(owner)
+	objects.SetPassword("correct horse")
+
+	for _, value := range []interface{}{objects, *objects} {
+
+		serialized, err := json.Marshal(value)
+		if err != nil {
+			fmt.Println(err.Error())
+		}
+
+		if strings.Contains(string(serialized), "password") {
+			fmt.Printf("%T: %s\n", value, serialized)
+		}
+	}
+}
+`
+
+func TestGoMarshalLeavesOutWriteOnly(test *testing.T) {
+
+	var output string
+
+	output = runGoConformance(test, []string{"objects"}, goMarshalLeavesOutWriteOnly)
+	if output != "" {
+		test.Errorf("Expected writeOnly fields to be left out, but got:\n%s", output)
+	}
+}
+
+/*
+	Breaks the uniqueItems constraints of the conformance "arrays" schema, with a duplicated string and a duplicated (nested) slice.
+*/
+const goDuplicateItems = `
+package main
+
+import (
+	"fmt"
+
+	"check/conformance"
+)
+
+func main() {
+
+	arrays, _ := conformance.NewArrays()
+
+	fmt.Println(arrays.SetTags([]string{"a", "b", "a"}))
+	fmt.Println(arrays.SetMatrix([][]int{{1, 2}, {1, 3}}))
+
+	arrays.Tags = []string{"a", "a"}
+	arrays.Matrix = [][]int{{1, 2}, {1, 2}}
+	fmt.Println(arrays.Validate())
+}
+`
+
+func TestGoDuplicateItems(test *testing.T) {
+
+	var output, expected string
+
+	expected = strings.Join([]string{
+		"Value has duplicate items",
+		"<nil>",
+		"tags: uniqueItems: has duplicate items, value: [a a]",
+		"matrix: uniqueItems: has duplicate items, value: [[1 2] [1 2]]",
+	}, "\n")
+
+	output = runGoConformance(test, []string{"arrays"}, goDuplicateItems)
+	if output != expected {
+		test.Errorf("Expected each array with duplicate items to be rejected, but got:\n%s", output)
+	}
+}
+
+/*
+	Breaks each condition of the conformance "conditionals" schema, the same way as pythonConditionalViolations.
+*/
+const goConditionalViolations = `
+package main
+
+import (
+	"fmt"
+
+	"check/conformance"
+)
+
+func main() {
+
+	conditionals, _ := conformance.NewConditionals()
+	conditionals.Country = "US"
+	conditionals.PostalCode = "abc"
+	conditionals.Card = "visa"
+
+	fmt.Println(conditionals.Validate())
+}
+`
+
+func TestGoConditionalViolations(test *testing.T) {
+
+	var output, expected string
+
+	// the same violations, worded the same way, as every other language (see CODEGEN.md).
+	expected = strings.Join([]string{
+		"state: required: is required",
+		"postalCode: pattern: did not match pattern '^[0-9]{5}$', value: abc",
+		"billingAddress: dependentRequired: is required when 'card' is present",
+	}, "\n")
+
+	output = runGoConformance(test, []string{"conditionals"}, goConditionalViolations)
+	if output != expected {
+		test.Errorf("Expected a violation for each field of each condition, but got:\n%s", output)
+	}
+}
+
+/*
+	Generates the Go for each of the given conformance [schemaNames] into one "conformance" package,
+	runs the given [program] (a main package, which imports it as "check/conformance"), and returns what it prints.
+*/
+func runGoConformance(test *testing.T, schemaNames []string, program string) string {
+
+	var context *SchemaParseContext
+	var files map[string]string
+	var command *exec.Cmd
+	var directory string
+	var output []byte
+	var err error
+
+	directory = test.TempDir()
+
+	for _, schemaName := range schemaNames {
+
+		context, err = parseConformanceSchema(filepath.Join(conformanceDirectory, "schemas", schemaName+".json"))
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		files, err = GenerateCode(context, "conformance", "go", "\t", false, true, GeneratorOptions{})
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		err = writeGoldenFiles(filepath.Join(directory, "conformance", schemaName), files)
+		if err != nil {
+			test.Fatal(err)
+		}
+	}
+
+	// every schema's files go in one package, so they're moved up out of their own directories.
+	for _, schemaName := range schemaNames {
+
+		files, _ := filepath.Glob(filepath.Join(directory, "conformance", schemaName, "*.go"))
+		for _, path := range files {
+
+			err = os.Rename(path, filepath.Join(directory, "conformance", filepath.Base(path)))
+			if err != nil {
+				test.Fatal(err)
+			}
+		}
+	}
+
+	err = os.WriteFile(filepath.Join(directory, "go.mod"), []byte("module check\n\ngo 1.18\n"), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join(directory, "main.go"), []byte(program), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	command = exec.Command("go", "run", ".")
+	command.Dir = directory
+
+	output, err = command.CombinedOutput()
+	if err != nil {
+		test.Fatalf("Running the generated code failed: %s\n%s", err.Error(), output)
+	}
+
+	return strings.TrimSuffix(string(output), "\n")
+}
@@ -2,6 +2,7 @@ package presilo
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 )
@@ -9,21 +10,29 @@ import (
 /*
   Generates valid Java code for a given schema.
 */
-func GenerateJava(schema *ObjectSchema, module string, tabstyle string) string {
+func GenerateJava(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
 
 	var buffer *BufferedFormatString
+	var model *ModelType
+
+	warnUncheckedNots(schema, "java", "Java")
 
 	buffer = NewBufferedFormatString(tabstyle)
+	model = NewModelType(schema)
 
 	buffer.Printf("package %s;\n", module)
 
-	generateJavaImports(schema, buffer)
+	generateJavaImports(model, buffer)
+	buffer.Print("\n")
+	generateJavaTypeDeclaration(model, options, buffer)
 	buffer.Print("\n")
-	generateJavaTypeDeclaration(schema, buffer)
+	generateJavaConstructor(model, buffer)
 	buffer.Print("\n")
-	generateJavaConstructor(schema, buffer)
+	generateJavaFunctions(model, buffer)
+	generateJavaConditions(schema, buffer)
+	generateJavaValidation(model, buffer)
 	buffer.Print("\n")
-	generateJavaFunctions(schema, buffer)
+	generateProtectedRegion(ToCamelCase(schema.GetTitle()), "//", buffer)
 
 	buffer.AddIndentation(-1)
 	buffer.Print("\n}\n")
@@ -38,44 +47,116 @@ func ValidateJavaModule(module string) bool {
 	return err == nil && matched
 }
 
-func generateJavaImports(schema *ObjectSchema, buffer *BufferedFormatString) {
+/*
+	Returns the path of the file the given [schema] is written to; in the directory of its package, named after its class.
+*/
+func GetJavaFileName(schema *ObjectSchema, module string) string {
+	return path.Join(strings.Replace(module, ".", "/", -1), ToCamelCase(schema.GetTitle()))
+}
+
+/*
+	Combines the code of several classes into one file, with one package declaration, and each import once.
+*/
+func CombineJavaFiles(code []string, module string) (string, error) {
+
+	return combineHeaderLines(code, func(line string) bool {
+		return strings.HasPrefix(line, "package ") || strings.HasPrefix(line, "import ")
+	}), nil
+}
+
+func generateJavaImports(model *ModelType, buffer *BufferedFormatString) {
+
+	var schema *ObjectSchema
+
+	schema = model.Schema
 
 	// import regex if we need it
 	if containsRegexpMatch(schema) {
 		buffer.Print("import java.util.regex.*;\n\n")
 	}
+
+	if containsBigInteger(schema) {
+		buffer.Print("import java.math.BigInteger;\n\n")
+	}
+
+	if containsDecimal(schema) {
+		buffer.Print("import java.math.BigDecimal;\n\n")
+	}
+
+	if len(model.GetWriteOnlyFields()) > 0 {
+		buffer.Print("import com.fasterxml.jackson.annotation.JsonProperty;\n\n")
+	}
+
+	for _, packageName := range getExtensionImports(schema, "java") {
+		buffer.Printf("import %s;\n\n", packageName)
+	}
 }
 
-func generateJavaTypeDeclaration(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateJavaTypeDeclaration(model *ModelType, options GeneratorOptions, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var subschema TypeSchema
-	var propertyName string
+	var fieldName, modifiers string
+
+	schema = model.Schema
+
+	if schema.IsDeprecated() {
+		buffer.Print("@Deprecated\n")
+	}
 
 	buffer.Printf("public class %s\n{", ToCamelCase(schema.Title))
 	buffer.AddIndentation(1)
 
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
+	for _, field := range model.Fields {
+
+		subschema = field.Schema
+		fieldName = getJavaFieldName(schema, field.Name)
+
+		generateJavaDeprecation(subschema, buffer)
+
+		modifiers = getFieldVisibility(options)
+
+		// writeOnly fields are deserialized, but left out of serialization.
+		if subschema.IsWriteOnly() {
+			buffer.Print("\n@JsonProperty(access = JsonProperty.Access.WRITE_ONLY)")
+		}
+
+		if field.IsConst {
+
+			if subschema.GetSchemaType() == SCHEMATYPE_INTEGER {
+				buffer.Printf("\n%s final %s %s = %s;", modifiers, GenerateJavaTypeForSchema(subschema), fieldName, getJavaIntegerLiteral(subschema.(*IntegerSchema), field.Const))
+				continue
+			}
+
+			if isDecimal(subschema) {
+				buffer.Printf("\n%s final %s %s = %s;", modifiers, GenerateJavaTypeForSchema(subschema), fieldName, getJavaDecimalLiteral(field.Const))
+				continue
+			}
 
-		subschema = schema.Properties[propertyName]
+			buffer.Printf("\n%s final %s %s = %s;", modifiers, GenerateJavaTypeForSchema(subschema), fieldName, getConstLiteral(field.Const, "true", "false"))
+			continue
+		}
 
-		buffer.Printf("\nprotected %s %s;", GenerateJavaTypeForSchema(subschema), ToJavaCase(propertyName))
+		buffer.Printf("\n%s %s %s;", modifiers, GenerateJavaTypeForSchema(subschema), fieldName)
 	}
 }
 
-func generateJavaConstructor(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateJavaConstructor(model *ModelType, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var subschema TypeSchema
 	var declarations, setters []string
 	var propertyName string
 	var toWrite string
 	var constrained bool
 
+	schema = model.Schema
 	buffer.Printf("\npublic %s(", ToCamelCase(schema.Title))
 
-	for _, propertyName = range schema.RequiredProperties {
+	for _, field := range model.ConstructorFields {
 
-		subschema = schema.Properties[propertyName]
-		propertyName = ToJavaCase(propertyName)
+		subschema = field.Schema
+		propertyName = getJavaFieldName(schema, field.Name)
 
 		if subschema.HasConstraints() {
 			constrained = true
@@ -106,20 +187,24 @@ func generateJavaConstructor(schema *ObjectSchema, buffer *BufferedFormatString)
 	buffer.Print("\n}\n")
 }
 
-func generateJavaFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateJavaFunctions(model *ModelType, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var subschema TypeSchema
-	var propertyName, properName, camelName, typeName string
+	var properName, camelName, typeName string
+
+	schema = model.Schema
 
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
+	for _, field := range model.Fields {
 
-		subschema = schema.Properties[propertyName]
+		subschema = field.Schema
 
-		properName = ToJavaCase(propertyName)
-		camelName = ToStrictCamelCase(propertyName)
+		properName = getJavaFieldName(schema, field.Name)
+		camelName = ToStrictCamelCase(getOverriddenName(schema, field.Name, "java"))
 		typeName = GenerateJavaTypeForSchema(subschema)
 
 		// getter
+		generateJavaDeprecation(subschema, buffer)
 		buffer.Printf("\npublic %s get%s()\n{", typeName, camelName)
 		buffer.AddIndentation(1)
 
@@ -128,7 +213,14 @@ func generateJavaFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}")
 
+		// readOnly and const fields are never set by consumers, no setter.
+		if !field.Settable {
+			buffer.Print("\n")
+			continue
+		}
+
 		// setter
+		generateJavaDeprecation(subschema, buffer)
 		buffer.Printf("\npublic void set%s(%s value)", camelName, typeName)
 
 		if subschema.HasConstraints() {
@@ -138,19 +230,13 @@ func generateJavaFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
 		buffer.Print("\n{")
 		buffer.AddIndentation(1)
 
-		switch subschema.GetSchemaType() {
-		case SCHEMATYPE_STRING:
-			generateJavaStringSetter(subschema.(*StringSchema), buffer)
-		case SCHEMATYPE_INTEGER:
-			fallthrough
-		case SCHEMATYPE_NUMBER:
-			generateJavaNumericSetter(subschema.(NumericSchemaType), buffer)
-		case SCHEMATYPE_OBJECT:
-			generateJavaObjectSetter(subschema.(*ObjectSchema), buffer)
-		case SCHEMATYPE_ARRAY:
-			generateJavaArraySetter(subschema.(*ArraySchema), buffer)
+		// only a setter can be given null to check; everything else checks values once it knows they're there.
+		if !subschema.GetNullable() && len(getJavaPresenceCheck(subschema, "value")) > 0 {
+			generateJavaNullCheck(buffer)
 		}
 
+		generateJavaChecks(subschema, failWithStatement, buffer)
+
 		buffer.Printf("\n%s = value;", properName)
 
 		buffer.AddIndentation(-1)
@@ -158,90 +244,659 @@ func generateJavaFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
 	}
 }
 
-func generateJavaStringSetter(schema *StringSchema, buffer *BufferedFormatString) {
+/*
+	Generates all the checks appropriate for the type of the given [subschema],
+	each of which reports (with [fail]) if 'value' violates a constraint.
+*/
+func generateJavaChecks(subschema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
 
-	if !schema.Nullable {
-		generateJavaNullCheck(buffer)
+	if hasTypeOverride(subschema, "java") {
+		return
 	}
 
-	if schema.MinLength != nil {
-		generateJavaRangeCheck(*schema.MinLength, "value.length()", "was shorter than allowable minimum", "%d", false, "<", "", buffer)
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING:
+		generateJavaStringSetter(subschema.(*StringSchema), fail, buffer)
+	case SCHEMATYPE_INTEGER:
+
+		if subschema.(*IntegerSchema).IsBig() {
+			generateJavaBigNumberSetter(subschema.(*IntegerSchema), "BigInteger", func(value interface{}) string {
+				return getJavaIntegerLiteral(subschema.(*IntegerSchema), value)
+			}, fail, buffer)
+			break
+		}
+		generateJavaNumericSetter(subschema.(NumericSchemaType), fail, buffer)
+	case SCHEMATYPE_NUMBER:
+
+		if subschema.(*NumberSchema).IsDecimal() {
+			generateJavaBigNumberSetter(subschema.(*NumberSchema), "BigDecimal", getJavaDecimalLiteral, fail, buffer)
+			break
+		}
+		generateJavaNumericSetter(subschema.(NumericSchemaType), fail, buffer)
+	case SCHEMATYPE_BOOLEAN:
+		generateJavaBooleanSetter(subschema.(*BooleanSchema), fail, buffer)
+	case SCHEMATYPE_ARRAY:
+		generateJavaArraySetter(subschema.(*ArraySchema), fail, buffer)
 	}
+}
+
+func generateJavaStringSetter(schema *StringSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var pattern, enum *ModelRule
+	var byteLength string
+
+	generateJavaNotCheck(schema, fail, buffer)
 
-	if schema.MaxLength != nil {
-		generateJavaRangeCheck(*schema.MaxLength, "value.length()", "was longer than allowable maximum", "%d", false, ">", "", buffer)
+	// Java strings are UTF-16, so every char is two bytes. Binary content is already bytes.
+	if schema.IsBinary() {
+		byteLength = "value.length"
+	} else {
+		byteLength = "value.length() * 2"
 	}
 
-	if schema.MinByteLength != nil {
-		generateJavaRangeCheck(*schema.MinByteLength, "value.length() * 2", "was shorter than allowable minimum bytes", "%d", false, "<", "", buffer)
+	generateJavaRangeChecks(schema, MODELSUBJECT_LENGTH, "value.length()", "%d", fail, buffer)
+	generateJavaRangeChecks(schema, MODELSUBJECT_BYTE_LENGTH, byteLength, "%d", fail, buffer)
+
+	pattern = getModelRule(schema, MODELRULE_PATTERN)
+	if pattern != nil {
+
+		buffer.Printf("\nPattern regex = Pattern.compile(\"%s\");", sanitizeQuotedString(pattern.Value.(string)))
+		buffer.Printf("\nif(!regex.matcher(value).matches())\n{")
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s", fail(pattern.Keyword, fmt.Sprintf("did not match pattern '%s'", pattern.Value), fmt.Sprintf("throw new Exception(\"Value '\"+value+\"' did not match pattern '%s'\");", pattern.Value)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}")
 	}
 
-	if schema.MaxByteLength != nil {
-		generateJavaRangeCheck(*schema.MaxByteLength, "value.length() * 2", "was larger than allowable maximum bytes", "%d", false, ">", "", buffer)
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateJavaEnumCheck(schema, enum, "\"", "\"", fail, buffer)
 	}
+}
 
-	if schema.Pattern != nil {
+func generateJavaNumericSetter(schema NumericSchemaType, fail checkFailure, buffer *BufferedFormatString) {
 
-		buffer.Printf("\nPattern regex = Pattern.compile(\"%s\");", sanitizeQuotedString(*schema.Pattern))
-		buffer.Printf("\nif(!regex.matcher(value).matches())\n{")
+	var enum, multiple *ModelRule
+	var format, postfix string
+
+	generateJavaNotCheck(schema.(TypeSchema), fail, buffer)
+
+	format = schema.GetConstraintFormat()
+
+	// long literals need a suffix, or javac will reject any which don't fit in an int.
+	if schema.GetSchemaType() == SCHEMATYPE_INTEGER && schema.(*IntegerSchema).GetWidth() == INTEGERWIDTH_64 {
+		postfix = "L"
+		format += postfix
+	}
+
+	generateJavaRangeChecks(schema, MODELSUBJECT_VALUE, "value", format, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateJavaEnumCheck(schema, enum, "", postfix, fail, buffer)
+	}
+
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
+
+		buffer.Printf("\nif(value %% %v%s != 0)\n{", multiple.Value, postfix)
 		buffer.AddIndentation(1)
 
-		buffer.Printf("\nthrow new Exception(\"Value '\"+value+\"' did not match pattern '%s'\");", *schema.Pattern)
+		buffer.Printf("\n%s", fail(multiple.Keyword, fmt.Sprintf("was not a multiple of %v", multiple.Value), fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' was not a multiple of %v\");", multiple.Value)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Generates checks for the given arbitrary-precision schema, whose values are of the given [typeName]
+	(BigInteger or BigDecimal), and whose constraints are written by the given [literal] function.
+	Neither can use the usual operators, so every comparison is made with compareTo().
+*/
+func generateJavaBigNumberSetter(schema NumericSchemaType, typeName string, literal func(interface{}) string, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum, multiple *ModelRule
+
+	generateJavaNotCheck(schema.(TypeSchema), fail, buffer)
+
+	// compareTo() is negative, zero or positive, so it compares to zero the same way value compares to the bound.
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, MODELSUBJECT_VALUE) {
+		generateJavaRangeCheck(rule, fmt.Sprintf("value.compareTo(%s)", literal(rule.Value)), "0", fail, buffer)
+	}
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		buffer.Printf("\n%s[] validValues = new %s[]{", typeName, typeName)
+		for i, enumValue := range enum.Value.([]interface{}) {
+
+			if i > 0 {
+				buffer.Print(",")
+			}
+			buffer.Print(literal(enumValue))
+		}
+		buffer.Print("};\n")
 
+		// BigDecimal.equals() also compares scale (so 1.5 != 1.50), compareTo() doesn't.
+		buffer.Print("\nboolean isValid = false;")
+		buffer.Print("\nfor(int i = 0; i < validValues.length; i++)\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nif(validValues[i].compareTo(value) == 0)\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nisValid = true;\nbreak;")
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}")
+
+		buffer.Print("\nif(!isValid)\n{")
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(enum.Keyword, "was not found in list of acceptable values", "throw new Exception(\"Given value '\"+value+\"' was not found in list of acceptable values\");"))
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
+
+		buffer.Printf("\nif(value.remainder(%s).signum() != 0)\n{", literal(multiple.Value))
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(multiple.Keyword, fmt.Sprintf("was not a multiple of %v", multiple.Value), fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' was not a multiple of %v\");", multiple.Value)))
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Returns a Java expression for the given integer [value], appropriate for the width of the given schema.
+*/
+func getJavaIntegerLiteral(schema *IntegerSchema, value interface{}) string {
+
+	switch schema.GetWidth() {
+	case INTEGERWIDTH_64:
+		return getIntegerString(value) + "L"
+	case INTEGERWIDTH_BIG:
+		return fmt.Sprintf("new BigInteger(\"%s\")", getIntegerString(value))
 	}
 
-	if schema.HasEnum() {
-		generateJavaEnumCheck(schema, schema.GetEnum(), "\"", "\"", buffer)
+	return getIntegerString(value)
+}
+
+/*
+	Returns a Java expression which creates a BigDecimal for the given decimal [value].
+*/
+func getJavaDecimalLiteral(value interface{}) string {
+	return fmt.Sprintf("new BigDecimal(\"%s\")", value)
+}
+
+func generateJavaBooleanSetter(schema *BooleanSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum *ModelRule
+	var value interface{}
+
+	generateJavaNotCheck(schema, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		value = enum.Value.([]interface{})[0]
+
+		buffer.Printf("\nif(value != %v)\n{", value)
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(enum.Keyword, fmt.Sprintf("must be %v", value), fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' must be %v.\");", value)))
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
 	}
 }
 
-func generateJavaNumericSetter(schema NumericSchemaType, buffer *BufferedFormatString) {
+/*
+	Generates code which throws if 'value' matches the "not" subschema of the given [schema].
+	The subschema's checks are run inside a try block, and the value is only valid if one of them throws.
+	This must be generated before any other checks, so that the locals declared by the subschema's checks
+	don't collide with the ones declared afterwards.
+*/
+func generateJavaNotCheck(schema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var not *ModelRule
+	var flag string
+
+	not = getModelRule(schema, MODELRULE_NOT)
+	if not == nil {
+		return
+	}
+
+	// nested checks can't redeclare locals, so each level of nesting gets its own flag.
+	flag = fmt.Sprintf("matchesNot%d", getNotDepth(schema))
+
+	buffer.Printf("\nboolean %s = true;", flag)
+	buffer.Print("\ntry\n{")
+	buffer.AddIndentation(1)
+
+	generateJavaChecks(not.Value.(TypeSchema), failWithStatement, buffer)
+
+	buffer.AddIndentation(-1)
+	buffer.Printf("\n}\ncatch(Exception e)\n{")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s = false;", flag)
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	buffer.Printf("\nif(%s)\n{", flag)
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(not.Keyword, not.Message, "throw new Exception(\"Property '\"+value+\"' matched a schema which it must not match.\");"))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+func generateJavaArraySetter(schema *ArraySchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	generateJavaRangeChecks(schema, MODELSUBJECT_ITEMS, "value.length", "%d", fail, buffer)
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_UNIQUE, MODELSUBJECT_ITEMS) {
+		generateJavaUniqueCheck(rule, fail, buffer)
+	}
+}
+
+/*
+	Generates a check which reports (with [fail]) if any two items of 'value' are equal, violating the given unique [rule].
+	Uses Objects.deepEquals, so that nested arrays and binary content are compared by their contents.
+*/
+func generateJavaUniqueCheck(rule *ModelRule, fail checkFailure, buffer *BufferedFormatString) {
+
+	buffer.Print("\nboolean duplicated = false;")
+	buffer.Print("\nfor(int i = 0; i < value.length && !duplicated; i++)\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nfor(int j = i + 1; j < value.length && !duplicated; j++)\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nduplicated = java.util.Objects.deepEquals(value[i], value[j]);")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	buffer.Print("\nif(duplicated)\n{")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(rule.Keyword, rule.Message, fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' %s.\");", rule.Message)))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates a "collectConditionalViolations" method for the given schema, which checks constraints that span multiple properties;
+	if/then/else, dependentRequired, and dependentSchemas. collectViolations() adds whatever it finds.
+	The "if" schema is generated as a protected method which throws on the first violation found, since it only decides
+	which of "then" or "else" applies. Each other conditional schema is generated as its own protected method,
+	which adds a violation for each field (beneath the given prefix) which doesn't meet it.
+*/
+func generateJavaConditions(schema *ObjectSchema, buffer *BufferedFormatString) {
+
+	var presence, dependencyPresence string
+
+	if !schema.HasConditions() {
+		return
+	}
+
+	buffer.Print("\nprotected void collectConditionalViolations(String prefix, java.util.List<String> violations)\n{")
+	buffer.AddIndentation(1)
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		buffer.Print("\nboolean matched = true;")
+		buffer.Print("\ntry\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nvalidateIf();")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\ncatch(Exception e)\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nmatched = false;")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+
+		if schema.Then != nil {
+
+			buffer.Print("\nif(matched)\n{")
+			buffer.AddIndentation(1)
+			buffer.Print("\ncollectThenConditionViolations(prefix, violations);")
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+
+			if schema.Else != nil {
+				buffer.Print("\nelse\n{")
+				buffer.AddIndentation(1)
+				buffer.Print("\ncollectElseConditionViolations(prefix, violations);")
+				buffer.AddIndentation(-1)
+				buffer.Print("\n}")
+			}
+		} else {
+			buffer.Print("\nif(!matched)\n{")
+			buffer.AddIndentation(1)
+			buffer.Print("\ncollectElseConditionViolations(prefix, violations);")
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+		buffer.Print("\n")
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		presence = getJavaPresenceCheck(schema.Properties[propertyName], "this."+getJavaFieldName(schema, propertyName))
+
+		// properties which always have a value get an unconditional block.
+		if len(presence) > 0 {
+			buffer.Printf("\nif(%s)\n{", presence)
+		} else {
+			buffer.Print("\n{")
+		}
+
+		buffer.AddIndentation(1)
+
+		for _, dependency := range schema.DependentRequired[propertyName] {
+
+			dependencyPresence = getJavaPresenceCheck(schema.Properties[dependency], "this."+getJavaFieldName(schema, dependency))
+			if len(dependencyPresence) == 0 {
+				continue
+			}
+
+			buffer.Printf("\nif(!(%s))\n{", dependencyPresence)
+			buffer.AddIndentation(1)
+			buffer.Printf("\nviolations.add(prefix + \"%s\");", getDependentRequiredViolationText(dependency, propertyName))
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+
+		if _, found := schema.DependentSchemas[propertyName]; found {
+			buffer.Printf("\ncollectDependent%sConditionViolations(prefix, violations);", ToStrictCamelCase(propertyName))
+		}
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
 
-	if schema.HasMinimum() {
-		generateJavaRangeCheck(schema.GetMinimum(), "value", "is under the allowable minimum", schema.GetConstraintFormat(), schema.IsExclusiveMinimum(), "<=", "<", buffer)
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		generateJavaCondition(schema, schema.If, "If", false, buffer)
+
+		if schema.Then != nil {
+			generateJavaCondition(schema, schema.Then, "Then", true, buffer)
+		}
+		if schema.Else != nil {
+			generateJavaCondition(schema, schema.Else, "Else", true, buffer)
+		}
 	}
 
-	if schema.HasMaximum() {
-		generateJavaRangeCheck(schema.GetMaximum(), "value", "is over the allowable maximum", schema.GetConstraintFormat(), schema.IsExclusiveMaximum(), ">=", ">", buffer)
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		if condition, found := schema.DependentSchemas[propertyName]; found {
+			generateJavaCondition(schema, condition, "Dependent"+ToStrictCamelCase(propertyName), true, buffer)
+		}
 	}
+}
+
+/*
+	Generates a protected method which checks the given [condition] against an instance of [schema].
+	If [collect]ing, the method adds a violation to the given list for each field which doesn't meet the condition
+	(described with the given prefix before its name); otherwise it throws on the first.
+	Constraints on properties are checked by reusing the setter checks against a local "value".
+	Properties which aren't present are not checked.
+*/
+func generateJavaCondition(schema *ObjectSchema, condition *ConditionalSchema, name string, collect bool, buffer *BufferedFormatString) {
 
-	if schema.HasEnum() {
-		generateJavaEnumCheck(schema, schema.GetEnum(), "", "", buffer)
+	var subschema TypeSchema
+	var failure checkFailure
+	var reference, presence string
+
+	if collect {
+		buffer.Printf("\nprotected void collect%sConditionViolations(String prefix, java.util.List<String> violations)\n{", name)
+	} else {
+		buffer.Printf("\nprotected void validate%s() throws Exception\n{", name)
 	}
+	buffer.AddIndentation(1)
 
-	if schema.HasMultiple() {
+	for _, propertyName := range condition.RequiredProperties {
 
-		buffer.Printf("\nif(value %% %f != 0)\n{", schema.GetMultiple())
+		presence = getJavaPresenceCheck(schema.Properties[propertyName], "this."+getJavaFieldName(schema, propertyName))
+		if len(presence) == 0 {
+			continue
+		}
+
+		buffer.Printf("\nif(!(%s))\n{", presence)
+		buffer.AddIndentation(1)
+
+		if collect {
+			buffer.Printf("\nviolations.add(prefix + \"%s: required: is required\");", sanitizeQuotedString(propertyName))
+		} else {
+			buffer.Printf("\nthrow new Exception(\"Property '%s' is required\");", propertyName)
+		}
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	for _, propertyName := range condition.GetOrderedPropertyNames() {
+
+		subschema = condition.Properties[propertyName]
+		if !subschema.HasConstraints() || hasTypeOverride(schema.Properties[propertyName], "java") {
+			continue
+		}
+
+		reference = "this." + getJavaFieldName(schema, propertyName)
+		presence = getJavaPresenceCheck(schema.Properties[propertyName], reference)
+
+		if len(presence) > 0 {
+			buffer.Printf("\nif(%s)", presence)
+		}
+
+		buffer.Print("\n{")
 		buffer.AddIndentation(1)
+		buffer.Printf("\n%s value = %s;", GenerateJavaTypeForSchema(subschema), reference)
 
-		buffer.Printf("\nthrow new Exception(\"Property '\"+value+\"' was not a multiple of %s\");", schema.GetMultiple())
+		if collect {
+
+			failure = chooseViolation(schema.Properties[propertyName], getJavaViolation, getJavaWriteOnlyViolation)
+			buffer.Printf("\nString path = prefix + \"%s\";", sanitizeQuotedString(propertyName))
+		} else {
+			failure = failWithStatement
+		}
+
+		generateJavaChecks(subschema, failure, buffer)
 
 		buffer.AddIndentation(-1)
 		buffer.Print("\n}\n")
 	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates a "validate" method for the given schema, which checks every constraint of every field (and of every object they hold),
+	and throws an exception which lists every violation, rather than stopping at the first.
+	The work is done by "collectViolations", which objects holding this one call with their own path.
+*/
+func generateJavaValidation(model *ModelType, buffer *BufferedFormatString) {
+
+	var schema *ObjectSchema
+	var fields []*ModelField
+	var reference string
+
+	schema = model.Schema
+	fields = getValidatedFields(model, "java")
+
+	buffer.Print("\npublic void validate() throws Exception\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\njava.util.List<String> violations = collectViolations(\"\", new java.util.ArrayList<String>());")
+	buffer.Print("\nif(!violations.isEmpty())\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nthrow new Exception(String.join(\"\\n\", violations));")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	buffer.Print("\npublic java.util.List<String> collectViolations(String path, java.util.List<String> violations)\n{")
+	buffer.AddIndentation(1)
+
+	if len(fields) > 0 || schema.HasConditions() {
+		buffer.Print("\nString prefix = path.isEmpty() ? \"\" : path + \".\";\n")
+	}
+
+	for _, field := range fields {
+
+		reference = "this." + getJavaFieldName(schema, field.Name)
+
+		// optional primitives can't be told apart from one that was never set while they're zero, so they're only checked once they aren't.
+		if isOptionalPrimitive(field, getJavaPresenceCheck(field.Schema, reference)) {
+			buffer.Printf("\nif(%s != 0)\n{", reference)
+			buffer.AddIndentation(1)
+		}
+
+		buffer.Printf("\ncollect%sViolations(prefix + \"%s\", %s, violations);", ToStrictCamelCase(getOverriddenName(schema, field.Name, "java")), sanitizeQuotedString(field.Name), reference)
+
+		if isOptionalPrimitive(field, getJavaPresenceCheck(field.Schema, reference)) {
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+	}
+
+	if len(fields) > 0 {
+		buffer.Print("\n")
+	}
+
+	if schema.HasConditions() {
+		buffer.Print("\ncollectConditionalViolations(prefix, violations);\n")
+	}
+
+	buffer.Print("\nreturn violations;")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	for _, field := range fields {
+		generateJavaValueValidation(field.Schema, chooseViolation(field.Schema, getJavaViolation, getJavaWriteOnlyViolation), ToStrictCamelCase(getOverriddenName(schema, field.Name, "java")), field.Required, buffer)
+	}
 }
 
-func generateJavaObjectSetter(schema *ObjectSchema, buffer *BufferedFormatString) {
+/*
+	Generates a protected method which checks a value of the given [subschema], and anything it holds,
+	named after the given (camel-cased) [name]. Each field (and the items of each array) has its own method,
+	so that the locals declared by each check never collide.
+	A value which is null is only a violation if it's [required]; primitives always have a value, and are always checked.
+*/
+func generateJavaValueValidation(subschema TypeSchema, failure checkFailure, name string, required bool, buffer *BufferedFormatString) {
+
+	var items TypeSchema
+	var presence string
+
+	buffer.Printf("\nprotected void collect%sViolations(String path, %s value, java.util.List<String> violations)\n{", name, GenerateJavaTypeForSchema(subschema))
+	buffer.AddIndentation(1)
+
+	presence = getJavaPresenceCheck(subschema, "value")
+	if len(presence) > 0 {
+
+		buffer.Printf("\nif(!(%s))\n{", presence)
+		buffer.AddIndentation(1)
+
+		if required {
+			buffer.Print("\nviolations.add(path + \": required: is required\");")
+		}
+
+		buffer.Print("\nreturn;")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	generateJavaChecks(subschema, failure, buffer)
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_OBJECT:
+		buffer.Print("\nvalue.collectViolations(path, violations);")
+
+	case SCHEMATYPE_ARRAY:
 
-	if !schema.Nullable {
-		generateJavaNullCheck(buffer)
+		items = subschema.(*ArraySchema).Items
+		if needsValidation(items, "java") {
+
+			buffer.Print("\nfor(int i = 0; i < value.length; i++)\n{")
+			buffer.AddIndentation(1)
+			buffer.Printf("\ncollect%sItemViolations(path + \"[\" + i + \"]\", value[i], violations);", name)
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	if subschema.GetSchemaType() == SCHEMATYPE_ARRAY && needsValidation(items, "java") {
+		generateJavaValueValidation(items, failure, name+"Item", false, buffer)
 	}
 }
 
-func generateJavaArraySetter(schema *ArraySchema, buffer *BufferedFormatString) {
+/*
+	A checkFailure which adds a description of the violation (with the path in 'path', and the offending 'value') to 'violations'.
+*/
+func getJavaViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.add(path + \"%s\" + value);", getViolationText(keyword, description))
+}
 
-	if !schema.Nullable {
-		generateJavaNullCheck(buffer)
+/*
+	A checkFailure like getJavaViolation, for writeOnly values, which leaves the value out of the description.
+*/
+func getJavaWriteOnlyViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.add(path + \"%s\");", getWriteOnlyViolationText(keyword, description))
+}
+
+/*
+	Returns an expression which is true if the field at [reference] has been given a value,
+	or an empty string if the field is a primitive (and so always has one), or its type is overridden.
+*/
+func getJavaPresenceCheck(subschema TypeSchema, reference string) string {
+
+	if len(getExtensionOverride(subschema, "java", EXTENSION_TYPE)) > 0 {
+		return ""
 	}
 
-	if schema.MinItems != nil {
-		generateJavaRangeCheck(*schema.MinItems, "value.length", "does not have enough items", "%d", false, "<", "", buffer)
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_INTEGER:
+
+		if subschema.(*IntegerSchema).IsBig() {
+			return reference + " != null"
+		}
+	case SCHEMATYPE_NUMBER:
+
+		if subschema.(*NumberSchema).IsDecimal() {
+			return reference + " != null"
+		}
+	case SCHEMATYPE_STRING:
+		fallthrough
+	case SCHEMATYPE_OBJECT:
+		fallthrough
+	case SCHEMATYPE_ARRAY:
+		return reference + " != null"
 	}
 
-	if schema.MaxItems != nil {
-		generateJavaRangeCheck(*schema.MaxItems, "value.length", "does not have enough items", "%d", false, ">", "", buffer)
+	return ""
+}
+
+/*
+	Returns the name of the field which holds the given property, using its "x-java-name" if it has one.
+*/
+func getJavaFieldName(schema *ObjectSchema, propertyName string) string {
+	return ToJavaCase(getOverriddenName(schema, propertyName, "java"))
+}
+
+/*
+	Generates a @Deprecated annotation if the given schema is deprecated.
+*/
+func generateJavaDeprecation(schema TypeSchema, buffer *BufferedFormatString) {
+
+	if schema.IsDeprecated() {
+		buffer.Print("\n@Deprecated")
 	}
 }
 
@@ -250,39 +905,47 @@ func generateJavaNullCheck(buffer *BufferedFormatString) {
 	buffer.Printf("\nif(value == null)\n{")
 	buffer.AddIndentation(1)
 
-	buffer.Printf("\nthrow new NullPointerException(\"Cannot set property to null value\");")
+	buffer.Print("\nthrow new NullPointerException(\"Cannot set property to null value\");")
 
 	buffer.AddIndentation(-1)
 	buffer.Printf("\n}\n")
 }
 
-func generateJavaRangeCheck(value interface{}, reference, message, format string, exclusive bool, comparator, exclusiveComparator string, buffer *BufferedFormatString) {
-
-	var compareString string
+/*
+	Generates a check for every range rule of the given [schema] on the given [subject],
+	comparing [reference] to each bound (formatted with [format]).
+*/
+func generateJavaRangeChecks(schema TypeSchema, subject ModelSubject, reference string, format string, fail checkFailure, buffer *BufferedFormatString) {
 
-	if exclusive {
-		compareString = exclusiveComparator
-	} else {
-		compareString = comparator
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, subject) {
+		generateJavaRangeCheck(rule, reference, fmt.Sprintf(format, rule.Value), fail, buffer)
 	}
+}
+
+/*
+	Generates a check which reports (with [fail]) if [reference] violates the given range [rule], with the bound written as [value].
+*/
+func generateJavaRangeCheck(rule *ModelRule, reference string, value string, fail checkFailure, buffer *BufferedFormatString) {
 
-	buffer.Printf("\nif(%s %s "+format+")\n{", reference, compareString, value)
+	buffer.Printf("\nif(%s %s %s)\n{", reference, rule.Violation, value)
 	buffer.AddIndentation(1)
 
-	buffer.Printf("\nthrow new Exception(\"Property '\"+value+\"' %s.\");", message)
+	buffer.Printf("\n%s", fail(rule.Keyword, fmt.Sprintf("%s (%v)", rule.Message, rule.Value), fmt.Sprintf("throw new Exception(\"Property '\"+value+\"' %s.\");", rule.Message)))
 
 	buffer.AddIndentation(-1)
 	buffer.Printf("\n}\n")
 }
 
 /*
-	Generates code which throws an error if the given [parameter]'s value is not contained in the given [validValues].
+	Generates code which reports (with [fail]) if 'value' is not one of the values of the given enum [rule].
 */
-func generateJavaEnumCheck(schema TypeSchema, enumValues []interface{}, prefix string, postfix string, buffer *BufferedFormatString) {
+func generateJavaEnumCheck(schema TypeSchema, rule *ModelRule, prefix string, postfix string, fail checkFailure, buffer *BufferedFormatString) {
 
-	var typeName string
+	var enumValues []interface{}
+	var typeName, comparison string
 	var length int
 
+	enumValues = rule.Value.([]interface{})
 	length = len(enumValues)
 
 	if length <= 0 {
@@ -298,13 +961,17 @@ func generateJavaEnumCheck(schema TypeSchema, enumValues []interface{}, prefix s
 	}
 	buffer.Print("};\n")
 
-	// compare
+	// primitives are compared by value, but == only compares whether two objects (such as Strings) are the same one.
+	comparison = "validValues[i] == value"
+	if len(getJavaPresenceCheck(schema, "value")) > 0 {
+		comparison = "validValues[i].equals(value)"
+	}
 
 	buffer.Print("\nboolean isValid = false;")
 	buffer.Print("\nfor(int i = 0; i < validValues.length; i++)\n{")
 	buffer.AddIndentation(1)
 
-	buffer.Print("\nif(validValues[i] == value)\n{")
+	buffer.Printf("\nif(%s)\n{", comparison)
 	buffer.AddIndentation(1)
 	buffer.Print("\nisValid = true;\nbreak;")
 
@@ -315,7 +982,7 @@ func generateJavaEnumCheck(schema TypeSchema, enumValues []interface{}, prefix s
 
 	buffer.Print("\nif(!isValid)\n{")
 	buffer.AddIndentation(1)
-	buffer.Print("\nthrow new Exception(\"Given value '\"+value+\"' was not found in list of acceptable values\");")
+	buffer.Printf("\n%s", fail(rule.Keyword, "was not found in list of acceptable values", "throw new Exception(\"Given value '\"+value+\"' was not found in list of acceptable values\");"))
 
 	buffer.AddIndentation(-1)
 	buffer.Print("\n}\n")
@@ -323,16 +990,39 @@ func generateJavaEnumCheck(schema TypeSchema, enumValues []interface{}, prefix s
 
 func GenerateJavaTypeForSchema(subschema TypeSchema) string {
 
+	var override string
+
+	// "x-java-type" replaces whatever type would otherwise be used.
+	override = getExtensionOverride(subschema, "java", EXTENSION_TYPE)
+	if len(override) > 0 {
+		return override
+	}
+
 	switch subschema.GetSchemaType() {
 	case SCHEMATYPE_NUMBER:
+
+		if subschema.(*NumberSchema).IsDecimal() {
+			return "BigDecimal"
+		}
 		return "double"
 	case SCHEMATYPE_INTEGER:
+
+		switch subschema.(*IntegerSchema).GetWidth() {
+		case INTEGERWIDTH_64:
+			return "long"
+		case INTEGERWIDTH_BIG:
+			return "BigInteger"
+		}
 		return "int"
 	case SCHEMATYPE_ARRAY:
-		return ToCamelCase(subschema.(*ArraySchema).Items.GetTitle()) + "[]"
+		return GenerateJavaTypeForSchema(subschema.(*ArraySchema).Items) + "[]"
 	case SCHEMATYPE_OBJECT:
 		return ToCamelCase(subschema.GetTitle())
 	case SCHEMATYPE_STRING:
+
+		if subschema.(*StringSchema).IsBinary() {
+			return "byte[]"
+		}
 		return "String"
 	case SCHEMATYPE_BOOLEAN:
 		return "boolean"
@@ -0,0 +1,1066 @@
+package presilo
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+/*
+  Generates valid JS code for a given schema.
+*/
+func GenerateJS(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
+
+	var buffer *BufferedFormatString
+	var model *ModelType
+
+	warnUncheckedNots(schema, "js", "JavaScript")
+
+	buffer = NewBufferedFormatString(tabstyle)
+	model = NewModelType(schema)
+
+	generateJSModuleCheck(buffer, module)
+	buffer.Print("\n")
+	generateJSConstructor(model, buffer, module)
+	buffer.Print("\n")
+	generateJSDeserializer(model, buffer, module)
+	buffer.Print("\n")
+	generateJSSerializer(model, buffer, module)
+	buffer.Print("\n")
+	generateJSFunctions(model, options, buffer, module)
+	buffer.Print("\n")
+	generateJSConditions(schema, buffer, module)
+	generateJSValidation(model, buffer, module)
+	buffer.Print("\n")
+	generateProtectedRegion(ToCamelCase(schema.GetTitle()), "//", buffer)
+	buffer.Print("\n")
+
+	return buffer.String()
+}
+
+func ValidateJSModule(module string) bool {
+
+	// TODO: This implementation isn't strictly correct, but is probably best practice.
+	// In the future, may want to implement the below pattern
+	// (which we can't do right now because it requires Perl-flavor regex)
+	// ref: http://stackoverflow.com/a/9337047
+
+	pattern := "^[a-zA-Z_]+[0-9a-zA-Z_]*(\\.[a-zA-Z_]+[0-9a-zA-Z_]*)*$"
+	matched, err := regexp.MatchString(pattern, module)
+	return err == nil && matched
+}
+
+/*
+	Returns the path of the file the given [schema] is written to; named after its schema, like its constructor.
+*/
+func GetJSFileName(schema *ObjectSchema, module string) string {
+	return schema.GetTitle()
+}
+
+/*
+	Generates code which loads the file of each of the given [dependencies] under CommonJS, before the [schema] which uses them.
+	Each file adds its type to the (global) module object, so nothing needs to be kept from each require.
+	Elsewhere (such as in a browser), files must already be loaded in dependency order.
+*/
+func GenerateJSImports(schema *ObjectSchema, dependencies []*ObjectSchema, module string, tabstyle string) string {
+
+	var buffer *BufferedFormatString
+
+	buffer = NewBufferedFormatString(tabstyle)
+	generateJSRequires(dependencies, module, buffer)
+
+	return buffer.String()
+}
+
+/*
+	Generates an "index.js" which loads every one of the given [schemas] under CommonJS, and exports the module object which holds them.
+*/
+func GenerateJSIndex(schemas []*ObjectSchema, module string, tabstyle string) (string, string) {
+
+	var buffer *BufferedFormatString
+
+	buffer = NewBufferedFormatString(tabstyle)
+
+	generateJSModuleCheck(buffer, module)
+	generateJSRequires(schemas, module, buffer)
+
+	buffer.Print("\nif(typeof(module) !== \"undefined\")\n{")
+	buffer.AddIndentation(1)
+	buffer.Printf("\nmodule.exports = %s;", module)
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	return "index", buffer.String()
+}
+
+func generateJSRequires(schemas []*ObjectSchema, module string, buffer *BufferedFormatString) {
+
+	buffer.Print("\nif(typeof(require) !== \"undefined\")\n{")
+	buffer.AddIndentation(1)
+
+	for _, schema := range schemas {
+		buffer.Printf("\nrequire(\"./%s.js\");", GetJSFileName(schema, module))
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+func generateJSModuleCheck(buffer *BufferedFormatString, module string) {
+
+	// check for undefined, first.
+	buffer.Printf("\nif(typeof(%s) === \"undefined\")\n{", module)
+	buffer.AddIndentation(1)
+
+	buffer.Printf("\n%s = {}", module)
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	// then null check.
+	buffer.Print("\nelse\n{")
+	buffer.AddIndentation(1)
+
+	buffer.Printf("\n%s = %s || {}", module, module)
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+func generateJSConstructor(model *ModelType, buffer *BufferedFormatString, module string) {
+
+	var schema *ObjectSchema
+	var parameterNames []string
+	var parameterName string
+
+	schema = model.Schema
+
+	// generate list of property names
+	for _, field := range model.ConstructorFields {
+		parameterNames = append(parameterNames, ToJavaCase(field.Name))
+	}
+
+	// write constructor signature
+	if schema.IsDeprecated() {
+		buffer.Printf("\n/**\n%s\n@deprecated\n*/\n", schema.Description)
+	} else {
+		buffer.Printf("\n/*\n%s\n*/\n", schema.Description)
+	}
+
+	buffer.Printf("\n%s.%s = function(", module, schema.Title)
+
+	buffer.Print(strings.Join(parameterNames, ","))
+	buffer.Print(")\n{")
+
+	buffer.AddIndentation(1)
+
+	// const fields are fixed, and have no setter.
+	for _, field := range model.GetConstFields() {
+		buffer.Printf("\nthis.%s = %s%s", ToJavaCase(field.Name), getConstLiteral(field.Const, "true", "false"), getJSIntegerPostfix(field.Schema))
+	}
+
+	// body
+	for _, parameterName = range parameterNames {
+		buffer.Printf("\nthis.set%s(%s)", ToStrictCamelCase(parameterName), parameterName)
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+func generateJSDeserializer(model *ModelType, buffer *BufferedFormatString, module string) {
+
+	var property TypeSchema
+	var ctorArguments []string
+	var argument, value string
+	var className string
+	var propertyName string
+
+	className = ToCamelCase(model.Name)
+
+	buffer.Printf("\n%s.%s.deserializeFrom = function(map)", module, className)
+	buffer.Printf("\n{")
+	buffer.AddIndentation(1)
+
+	// use constructor
+	buffer.Printf("\nvar ret = new %s.%s(", module, className)
+
+	for _, field := range model.ConstructorFields {
+
+		argument = fmt.Sprintf("map[\"%s\"]", sanitizeQuotedString(field.Name))
+		ctorArguments = append(ctorArguments, getJSDeserializedValue(field.Schema, argument, module))
+	}
+
+	buffer.Printf("%s)", strings.Join(ctorArguments, ", "))
+
+	// misc setters
+	buffer.Printf("\n")
+	for _, field := range model.Fields {
+
+		property = field.Schema
+		propertyName = field.Name
+
+		// if it's already set (or can only have one value), skip it.
+		if model.IsConstructorField(field) || field.IsConst {
+			continue
+		}
+
+		value = getJSDeserializedValue(property, fmt.Sprintf("map[\"%s\"]", sanitizeQuotedString(propertyName)), module)
+
+		// if it's constrained, use the setter (readOnly fields have none)
+		if property.HasConstraints() && field.Settable {
+
+			buffer.Printf("\nret.set%s(%s)", ToStrictCamelCase(propertyName), value)
+			continue
+		}
+
+		// otherwise set.
+		buffer.Printf("\nret.%s = %s", ToJavaCase(propertyName), value)
+	}
+
+	buffer.Printf("\nreturn ret")
+	buffer.AddIndentation(-1)
+	buffer.Printf("\n}\n")
+}
+
+/*
+	Generates a "toJSON" method which leaves out writeOnly fields, writes any BigInt as a string
+	(JSON.stringify refuses to serialize them, and a JSON number would lose precision once parsed),
+	and writes binary content (Uint8Array) as base64.
+	If there are no such fields, nothing is generated, and default serialization is used.
+*/
+func generateJSSerializer(model *ModelType, buffer *BufferedFormatString, module string) {
+
+	var schema *ObjectSchema
+	var writeOnly []string
+	var hasBigInteger, hasBinary bool
+
+	schema = model.Schema
+	hasBigInteger = containsBigInteger(schema)
+	hasBinary = containsBinary(schema)
+
+	for _, field := range model.GetWriteOnlyFields() {
+		writeOnly = append(writeOnly, fmt.Sprintf("\"%s\"", ToJavaCase(field.Name)))
+	}
+
+	if len(writeOnly) <= 0 && !hasBigInteger && !hasBinary {
+		return
+	}
+
+	buffer.Printf("\n%s.%s.prototype.toJSON = function()\n{", module, ToCamelCase(schema.Title))
+	buffer.AddIndentation(1)
+
+	buffer.Printf("\nvar writeOnly = [%s]", strings.Join(writeOnly, ","))
+
+	if hasBigInteger || hasBinary {
+
+		buffer.Print("\nvar encode = function(item)\n{")
+		buffer.AddIndentation(1)
+
+		if hasBigInteger {
+			buffer.Print("\nif(typeof(item) === \"bigint\")\n{")
+			buffer.AddIndentation(1)
+			buffer.Print("\nreturn item.toString()")
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+
+		if hasBinary {
+			buffer.Print("\nif(item instanceof Uint8Array)\n{")
+			buffer.AddIndentation(1)
+			buffer.Print("\nreturn btoa(Array.prototype.map.call(item, function(octet) { return String.fromCharCode(octet) }).join(\"\"))")
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+
+		buffer.Print("\nreturn item")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}")
+	}
+
+	buffer.Print("\nvar ret = {}")
+	buffer.Print("\nfor(var key in this)\n{")
+	buffer.AddIndentation(1)
+
+	buffer.Print("\nif(this.hasOwnProperty(key) && writeOnly.indexOf(key) < 0)\n{")
+	buffer.AddIndentation(1)
+
+	if hasBigInteger || hasBinary {
+		buffer.Print("\nret[key] = Array.isArray(this[key]) ? this[key].map(encode) : encode(this[key])")
+	} else {
+		buffer.Print("\nret[key] = this[key]")
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.Print("\nreturn ret")
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+func generateJSFunctions(model *ModelType, options GeneratorOptions, buffer *BufferedFormatString, module string) {
+
+	var subschema TypeSchema
+	var propertyNameCamel, propertyNameJava, schemaName string
+
+	schemaName = ToCamelCase(model.Name)
+
+	for _, field := range model.Fields {
+
+		subschema = field.Schema
+		propertyNameCamel = ToStrictCamelCase(field.Name)
+		propertyNameJava = ToJavaCase(field.Name)
+
+		// getters are only needed when every field has accessors, since fields are otherwise read directly.
+		if options.AllAccessors {
+
+			if subschema.IsDeprecated() {
+				buffer.Print("\n/** @deprecated */")
+			}
+
+			buffer.Printf("\n%s.%s.prototype.get%s = function()\n{", module, schemaName, propertyNameCamel)
+			buffer.AddIndentation(1)
+			buffer.Printf("\nreturn this.%s;", propertyNameJava)
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}\n")
+		}
+
+		// readOnly and const fields are never set by consumers, no setter.
+		if !field.Settable {
+			continue
+		}
+
+		if subschema.IsDeprecated() {
+			buffer.Print("\n/** @deprecated */")
+		}
+
+		buffer.Printf("\n%s.%s.prototype.set%s = function(value)\n{", module, schemaName, propertyNameCamel)
+		buffer.AddIndentation(1)
+
+		// undefined check
+		buffer.Printf("\nif(typeof(value) === 'undefined')\n{")
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\nthrow new ReferenceError(\"Cannot set property '%s', no value given\")", propertyNameJava)
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+
+		generateJSChecks(subschema, failWithStatement, buffer, module)
+
+		buffer.Printf("\nthis.%s = value;", propertyNameJava)
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Generates a "collectConditionalViolations" method for the given schema, which checks constraints that span multiple properties;
+	if/then/else, dependentRequired, and dependentSchemas. collectViolations() adds whatever it finds.
+	The "if" schema is generated as a method which throws on the first violation found, since it only decides
+	which of "then" or "else" applies. Each other conditional schema is generated as its own method,
+	which adds a violation for each field (beneath the given prefix) which doesn't meet it.
+*/
+func generateJSConditions(schema *ObjectSchema, buffer *BufferedFormatString, module string) {
+
+	var schemaName string
+
+	if !schema.HasConditions() {
+		return
+	}
+
+	schemaName = ToCamelCase(schema.Title)
+
+	buffer.Printf("\n/*\nAdds a description of each constraint of this %s which spans more than one field, and which it violates, to [violations].\n*/", schemaName)
+	buffer.Printf("\n%s.%s.prototype.collectConditionalViolations = function(prefix, violations)\n{", module, schemaName)
+	buffer.AddIndentation(1)
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		buffer.Print("\nvar matched = true")
+		buffer.Print("\ntry\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nthis.validateIf()")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\ncatch(e)\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nmatched = false")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+
+		if schema.Then != nil {
+
+			buffer.Print("\nif(matched)\n{")
+			buffer.AddIndentation(1)
+			buffer.Print("\nthis.collectThenConditionViolations(prefix, violations)")
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+
+			if schema.Else != nil {
+				buffer.Print("\nelse\n{")
+				buffer.AddIndentation(1)
+				buffer.Print("\nthis.collectElseConditionViolations(prefix, violations)")
+				buffer.AddIndentation(-1)
+				buffer.Print("\n}")
+			}
+		} else {
+			buffer.Print("\nif(!matched)\n{")
+			buffer.AddIndentation(1)
+			buffer.Print("\nthis.collectElseConditionViolations(prefix, violations)")
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+		buffer.Print("\n")
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		buffer.Printf("\nif(this.%s != null)\n{", ToJavaCase(propertyName))
+		buffer.AddIndentation(1)
+
+		for _, dependency := range schema.DependentRequired[propertyName] {
+
+			buffer.Printf("\nif(this.%s == null)\n{", ToJavaCase(dependency))
+			buffer.AddIndentation(1)
+			buffer.Printf("\nviolations.push(prefix + \"%s\")", getDependentRequiredViolationText(dependency, propertyName))
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+
+		if _, found := schema.DependentSchemas[propertyName]; found {
+			buffer.Printf("\nthis.collectDependent%sConditionViolations(prefix, violations)", ToStrictCamelCase(propertyName))
+		}
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		generateJSCondition(schema, schema.If, "If", false, schemaName, module, buffer)
+
+		if schema.Then != nil {
+			generateJSCondition(schema, schema.Then, "Then", true, schemaName, module, buffer)
+		}
+		if schema.Else != nil {
+			generateJSCondition(schema, schema.Else, "Else", true, schemaName, module, buffer)
+		}
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		if condition, found := schema.DependentSchemas[propertyName]; found {
+			generateJSCondition(schema, condition, "Dependent"+ToStrictCamelCase(propertyName), true, schemaName, module, buffer)
+		}
+	}
+}
+
+/*
+	Generates a "validate" method for the given schema, which checks every constraint of every field (and of every object they hold),
+	and throws an Error which lists every violation, rather than stopping at the first.
+	The work is done by "collectViolations", which objects holding this one call with their own path.
+*/
+func generateJSValidation(model *ModelType, buffer *BufferedFormatString, module string) {
+
+	var schema *ObjectSchema
+	var fields []*ModelField
+	var schemaName string
+
+	schema = model.Schema
+	schemaName = ToCamelCase(schema.Title)
+	fields = getValidatedFields(model, "js")
+
+	buffer.Printf("\n/*\nChecks every constraint of this %s, and of every object it holds,", schemaName)
+	buffer.Print("\nand throws an Error which lists every violation.\n*/")
+	buffer.Printf("\n%s.%s.prototype.validate = function()\n{", module, schemaName)
+	buffer.AddIndentation(1)
+	buffer.Print("\nvar violations = this.collectViolations(\"\", [])")
+	buffer.Print("\nif(violations.length > 0)\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nthrow new Error(violations.join(\"\\n\"))")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	buffer.Printf("\n/*\nAdds a description of every constraint this %s violates to [violations], and returns them.", schemaName)
+	buffer.Print("\nEach is described with the path of the field which violated it, beneath the given [path] of this object.\n*/")
+	buffer.Printf("\n%s.%s.prototype.collectViolations = function(path, violations)\n{", module, schemaName)
+	buffer.AddIndentation(1)
+
+	if len(fields) > 0 || schema.HasConditions() {
+		buffer.Print("\nvar prefix = path === \"\" ? \"\" : path + \".\"\n")
+	}
+
+	for _, field := range fields {
+		buffer.Printf("\nthis.collect%sViolations(prefix + \"%s\", this.%s, violations)", ToStrictCamelCase(field.Name), sanitizeQuotedString(field.Name), ToJavaCase(field.Name))
+	}
+
+	if len(fields) > 0 {
+		buffer.Print("\n")
+	}
+
+	if schema.HasConditions() {
+		buffer.Print("\nthis.collectConditionalViolations(prefix, violations)\n")
+	}
+
+	buffer.Print("\nreturn violations")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	for _, field := range fields {
+		generateJSValueValidation(field.Schema, chooseViolation(field.Schema, getJSViolation, getJSWriteOnlyViolation), ToStrictCamelCase(field.Name), field.Required, schemaName, module, buffer)
+	}
+}
+
+/*
+	Generates a method which checks a value of the given [subschema], and anything it holds,
+	named after the given (camel-cased) [name]. Each field (and the items of each array) has its own method,
+	so that checking the items of an array doesn't clobber the array's own 'value' and 'path'.
+	A value which is missing is only a violation if it's [required].
+*/
+func generateJSValueValidation(subschema TypeSchema, failure checkFailure, name string, required bool, schemaName string, module string, buffer *BufferedFormatString) {
+
+	var items TypeSchema
+
+	buffer.Printf("\n%s.%s.prototype.collect%sViolations = function(path, value, violations)\n{", module, schemaName, name)
+	buffer.AddIndentation(1)
+
+	buffer.Print("\nif(value == null)\n{")
+	buffer.AddIndentation(1)
+
+	if required {
+		buffer.Print("\nviolations.push(path + \": required: is required\")")
+	}
+
+	buffer.Print("\nreturn")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	generateJSChecks(subschema, failure, buffer, module)
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_OBJECT:
+
+		// a value of the wrong type has already been reported, and may have nothing to collect.
+		buffer.Print("\nif(typeof(value.collectViolations) === \"function\")\n{")
+		buffer.AddIndentation(1)
+		buffer.Print("\nvalue.collectViolations(path, violations)")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}")
+
+	case SCHEMATYPE_ARRAY:
+
+		items = subschema.(*ArraySchema).Items
+		if needsValidation(items, "js") {
+
+			buffer.Print("\nfor(var i = 0; i < value.length; i++)\n{")
+			buffer.AddIndentation(1)
+			buffer.Printf("\nthis.collect%sItemViolations(path + \"[\" + i + \"]\", value[i], violations)", name)
+			buffer.AddIndentation(-1)
+			buffer.Print("\n}")
+		}
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	if subschema.GetSchemaType() == SCHEMATYPE_ARRAY && needsValidation(items, "js") {
+		generateJSValueValidation(items, failure, name+"Item", false, schemaName, module, buffer)
+	}
+}
+
+/*
+	A checkFailure which adds a description of the violation (with the path in 'path', and the offending 'value') to 'violations'.
+*/
+func getJSViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.push(path + \"%s\" + value)", getViolationText(keyword, description))
+}
+
+/*
+	A checkFailure like getJSViolation, for writeOnly values, which leaves the value out of the description.
+*/
+func getJSWriteOnlyViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.push(path + \"%s\")", getWriteOnlyViolationText(keyword, description))
+}
+
+/*
+	Generates a method which checks the given [condition] against an instance of [schema].
+	If [collect]ing, the method adds a violation to the given list for each field which doesn't meet the condition
+	(described with the given prefix before its name); otherwise it throws on the first.
+	Constraints on properties are checked by reusing the setter checks against a local "value".
+	Properties which aren't present are not checked.
+*/
+func generateJSCondition(schema *ObjectSchema, condition *ConditionalSchema, name string, collect bool, schemaName string, module string, buffer *BufferedFormatString) {
+
+	var subschema TypeSchema
+	var failure checkFailure
+
+	if collect {
+		buffer.Printf("\n%s.%s.prototype.collect%sConditionViolations = function(prefix, violations)\n{", module, schemaName, name)
+	} else {
+		buffer.Printf("\n%s.%s.prototype.validate%s = function()\n{", module, schemaName, name)
+	}
+	buffer.AddIndentation(1)
+
+	for _, propertyName := range condition.RequiredProperties {
+
+		buffer.Printf("\nif(this.%s == null)\n{", ToJavaCase(propertyName))
+		buffer.AddIndentation(1)
+
+		if collect {
+			buffer.Printf("\nviolations.push(prefix + \"%s: required: is required\")", sanitizeQuotedString(propertyName))
+		} else {
+			buffer.Printf("\nthrow new Error(\"Property '%s' is required\")", propertyName)
+		}
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	if collect {
+		buffer.Print("\nvar path")
+	}
+	buffer.Print("\nvar value")
+
+	for _, propertyName := range condition.GetOrderedPropertyNames() {
+
+		subschema = condition.Properties[propertyName]
+		if !subschema.HasConstraints() {
+			continue
+		}
+
+		failure = failWithStatement
+
+		if collect {
+
+			failure = chooseViolation(schema.Properties[propertyName], getJSViolation, getJSWriteOnlyViolation)
+			buffer.Printf("\npath = prefix + \"%s\"", sanitizeQuotedString(propertyName))
+		}
+
+		buffer.Printf("\nvalue = this.%s", ToJavaCase(propertyName))
+		buffer.Print("\nif(value != null)\n{")
+		buffer.AddIndentation(1)
+
+		generateJSChecks(subschema, failure, buffer, module)
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates all the checks appropriate for the type of the given [subschema],
+	each of which reports (with [fail]) if 'value' violates a constraint.
+*/
+func generateJSChecks(subschema TypeSchema, fail checkFailure, buffer *BufferedFormatString, module string) {
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING:
+		generateJSStringSetter(subschema.(*StringSchema), fail, buffer, module)
+	case SCHEMATYPE_INTEGER:
+		fallthrough
+	case SCHEMATYPE_NUMBER:
+		generateJSNumericSetter(subschema.(NumericSchemaType), fail, buffer, module)
+	case SCHEMATYPE_BOOLEAN:
+		generateJSBooleanSetter(subschema.(*BooleanSchema), fail, buffer, module)
+	case SCHEMATYPE_OBJECT:
+		generateJSObjectSetter(subschema.(*ObjectSchema), fail, buffer, module)
+	case SCHEMATYPE_ARRAY:
+		generateJSArraySetter(subschema.(*ArraySchema), fail, buffer, module)
+	}
+}
+
+/*
+	Returns checks appropriate for verifying a boolean value's "const" and "not" constraints.
+*/
+func generateJSBooleanSetter(schema *BooleanSchema, fail checkFailure, buffer *BufferedFormatString, module string) {
+
+	var enum *ModelRule
+	var value interface{}
+
+	generateJSNotCheck(schema, fail, buffer, module)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		value = enum.Value.([]interface{})[0]
+
+		buffer.Printf("\nif(value !== %v)\n{", value)
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(enum.Keyword, fmt.Sprintf("must be %v", value), fmt.Sprintf("throw new Error(\"Property '\"+value+\"' must be %v.\")", value)))
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Generates code which throws if 'value' matches the "not" subschema of the given [schema].
+	The subschema's checks are run inside a try block, and the value is only valid if one of them throws.
+*/
+func generateJSNotCheck(schema TypeSchema, fail checkFailure, buffer *BufferedFormatString, module string) {
+
+	var not *ModelRule
+	var flag string
+
+	not = getModelRule(schema, MODELRULE_NOT)
+	if not == nil {
+		return
+	}
+
+	// vars are function-scoped, so each level of nesting needs its own flag to avoid clobbering the others.
+	flag = fmt.Sprintf("matchesNot%d", getNotDepth(schema))
+
+	buffer.Printf("\nvar %s = true", flag)
+	buffer.Print("\ntry\n{")
+	buffer.AddIndentation(1)
+
+	generateJSChecks(not.Value.(TypeSchema), failWithStatement, buffer, module)
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\ncatch(e)\n{")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s = false", flag)
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	buffer.Printf("\nif(%s)\n{", flag)
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(not.Keyword, not.Message, "throw new Error(\"Property '\"+value+\"' matched a schema which it must not match.\")"))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Returns checks appropriate for verifying an object's type.
+*/
+func generateJSObjectSetter(schema *ObjectSchema, fail checkFailure, buffer *BufferedFormatString, module string) {
+
+	generateJSTypeCheck(schema, fail, buffer, module)
+}
+
+/*
+	Returns checks appropriate for verifying a numeric value and its constraints.
+*/
+func generateJSNumericSetter(schema NumericSchemaType, fail checkFailure, buffer *BufferedFormatString, module string) {
+
+	var enum, multiple *ModelRule
+	var postfix string
+
+	generateJSNotCheck(schema.(TypeSchema), fail, buffer, module)
+	generateJSTypeCheck(schema, fail, buffer, module)
+
+	postfix = getJSIntegerPostfix(schema.(TypeSchema))
+
+	generateJSRangeChecks(schema.(TypeSchema), MODELSUBJECT_VALUE, "value", schema.GetConstraintFormat()+postfix, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateJSEnumCheck(buffer, enum, "", postfix, fail)
+	}
+
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
+
+		buffer.Printf("\nif(value %% %v%s != 0%s)\n{", multiple.Value, postfix, postfix)
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s", fail(multiple.Keyword, fmt.Sprintf("was not a multiple of %v", multiple.Value), fmt.Sprintf("throw new Error(\"Property '\"+value+\"' was not a multiple of %v\")", multiple.Value)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+}
+
+/*
+	Returns checks appropriate for verifying a string value and its constraints.
+*/
+func generateJSStringSetter(schema *StringSchema, fail checkFailure, buffer *BufferedFormatString, module string) {
+
+	var pattern, enum *ModelRule
+
+	generateJSNotCheck(schema, fail, buffer, module)
+	generateJSTypeCheck(schema, fail, buffer, module)
+
+	// byte lengths are only supported for binary content, whose length is already in bytes.
+	if schema.IsBinary() {
+		generateJSRangeChecks(schema, MODELSUBJECT_BYTE_LENGTH, "value.length", "%d", fail, buffer)
+	}
+
+	generateJSRangeChecks(schema, MODELSUBJECT_LENGTH, "value.length", "%d", fail, buffer)
+
+	pattern = getModelRule(schema, MODELRULE_PATTERN)
+	if pattern != nil {
+
+		buffer.Printf("\nvar regex = new RegExp(\"%s\")", pattern.Value)
+		buffer.Printf("\nif(!regex.test(value))\n{")
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s", fail(pattern.Keyword, fmt.Sprintf("did not match pattern '%s'", pattern.Value), fmt.Sprintf("throw new Error(\"Property '\"+value+\"' did not match pattern '%s'\")", pattern.Value)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateJSEnumCheck(buffer, enum, "\"", "\"", fail)
+	}
+}
+
+/*
+	Returns checks appropriate for verifying an array value and its constraints.
+*/
+func generateJSArraySetter(schema *ArraySchema, fail checkFailure, buffer *BufferedFormatString, module string) {
+
+	generateJSTypeCheck(schema, fail, buffer, module)
+	// TODO: value uniformity check
+
+	generateJSRangeChecks(schema, MODELSUBJECT_ITEMS, "value.length", "%d", fail, buffer)
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_UNIQUE, MODELSUBJECT_ITEMS) {
+		generateJSUniqueCheck(schema, rule, fail, buffer)
+	}
+}
+
+/*
+	Generates a check which reports (with [fail]) if any two items of 'value' are equal, violating the given unique [rule].
+	A Set only tells objects (and arrays, and binary content) apart by identity, so the items of the given [schema]
+	are compared as JSON unless they're primitives. BigInts can't be written as JSON, so they're written as strings.
+*/
+func generateJSUniqueCheck(schema *ArraySchema, rule *ModelRule, fail checkFailure, buffer *BufferedFormatString) {
+
+	var itemType SchemaType
+	var items string
+
+	itemType = schema.Items.GetSchemaType()
+	items = "value"
+
+	if itemType == SCHEMATYPE_OBJECT || itemType == SCHEMATYPE_ARRAY || isJSBinary(schema.Items) {
+		items = "value.map(function(item) { return JSON.stringify(item, function(key, field) { return typeof(field) === \"bigint\" ? field.toString() : field }) })"
+	}
+
+	buffer.Printf("\nif(new Set(%s).size < value.length)\n{", items)
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(rule.Keyword, rule.Message, fmt.Sprintf("throw new RangeError(\"Property '\"+value+\"' %s.\")", rule.Message)))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates a check for every range rule of the given [schema] on the given [subject],
+	comparing [reference] to each bound (formatted with [format]).
+*/
+func generateJSRangeChecks(schema TypeSchema, subject ModelSubject, reference string, format string, fail checkFailure, buffer *BufferedFormatString) {
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, subject) {
+		generateJSRangeCheck(rule, reference, fmt.Sprintf(format, rule.Value), fail, buffer)
+	}
+}
+
+/*
+	Generates a check which reports (with [fail]) if [reference] violates the given range [rule], with the bound written as [value].
+*/
+func generateJSRangeCheck(rule *ModelRule, reference string, value string, fail checkFailure, buffer *BufferedFormatString) {
+
+	buffer.Printf("\nif(%s %s %s)\n{", reference, rule.Violation, value)
+	buffer.AddIndentation(1)
+
+	buffer.Printf("\n%s", fail(rule.Keyword, fmt.Sprintf("%s (%v)", rule.Message, rule.Value), fmt.Sprintf("throw new RangeError(\"Property '\"+value+\"' %s.\")", rule.Message)))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+}
+
+/*
+	Generates code which reports (with [fail]) if 'value' is not of the type of the given [schema].
+	Objects are compared to the constructor of their type in the given [module].
+*/
+func generateJSTypeCheck(schema TypeSchema, fail checkFailure, buffer *BufferedFormatString, module string) {
+
+	var schemaType SchemaType
+	var expectedType string
+	var shouldWriteCtorCheck bool
+
+	schemaType = schema.GetSchemaType()
+	expectedType = getJSTypeFromSchemaType(schemaType)
+
+	if schemaType == SCHEMATYPE_INTEGER && schema.(*IntegerSchema).IsBig() {
+		expectedType = "bigint"
+	}
+
+	if isJSBinary(schema) {
+		expectedType = "object"
+	}
+
+	if schema.GetNullable() {
+		buffer.Printf("\nif(value != null)\n{")
+		buffer.AddIndentation(1)
+	}
+
+	buffer.Printf("\nif(typeof(value) !== \"%s\")\n{", expectedType)
+	buffer.AddIndentation(1)
+
+	buffer.Printf("\n%s", fail("type", fmt.Sprintf("was not of the expected type '%s'", expectedType), fmt.Sprintf("throw new TypeError(\"Property \"+value+\" was not of the expected type '%s'\")", expectedType)))
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}\n")
+
+	// if this is an array or object, check the constructor
+	shouldWriteCtorCheck = false
+
+	switch schemaType {
+	case SCHEMATYPE_ARRAY:
+		shouldWriteCtorCheck = true
+		expectedType = "Array"
+	case SCHEMATYPE_OBJECT:
+		shouldWriteCtorCheck = true
+		expectedType = module + "." + ToCamelCase(schema.GetTitle())
+	case SCHEMATYPE_STRING:
+		shouldWriteCtorCheck = isJSBinary(schema)
+		expectedType = "Uint8Array"
+	}
+
+	if shouldWriteCtorCheck {
+
+		buffer.Printf("\nif(value.constructor !== %s)\n{", expectedType)
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s", fail("type", fmt.Sprintf("was not of the expected type '%s'", expectedType), fmt.Sprintf("throw new TypeError(\"Property '\"+value+\"'was not of the expected type '%s'\")", expectedType)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\n}\n")
+	}
+
+	if schema.GetNullable() {
+		buffer.AddIndentation(-1)
+		buffer.Printf("\n}")
+	}
+}
+
+/*
+	Generates code which reports (with [fail]) if 'value' is not one of the values of the given enum [rule].
+*/
+func generateJSEnumCheck(buffer *BufferedFormatString, rule *ModelRule, prefix string, postfix string, fail checkFailure) {
+
+	var enumValues []interface{}
+	var length int
+
+	enumValues = rule.Value.([]interface{})
+	length = len(enumValues)
+
+	if length <= 0 {
+		return
+	}
+
+	// write array of valid values
+	buffer.Printf("\nvar validValues = [%s%v%s", prefix, enumValues[0], postfix)
+
+	for _, enumValue := range enumValues[1:length] {
+		buffer.Printf(",%s%v%s", prefix, enumValue, postfix)
+	}
+	buffer.Print("]\n")
+
+	// compare
+	buffer.Print("\nvar isValid = false")
+	buffer.Print("\nfor(var i = 0; i < validValues.length; i++) \n{")
+	buffer.AddIndentation(1)
+
+	buffer.Print("\nif(validValues[i] === value)\n{")
+	buffer.AddIndentation(1)
+	buffer.Print("\nisValid = true")
+	buffer.Print("\nbreak;")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+
+	buffer.Print("\nif(!isValid)\n{")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(rule.Keyword, "was not found in list of acceptable values", "throw new Error(\"Given value '\"+value+\"' was not found in list of acceptable values\")"))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n}")
+}
+
+/*
+	Returns the literal postfix needed for numbers of the given schema;
+	"n" for arbitrary-precision integers (which are BigInts), and nothing otherwise.
+*/
+func getJSIntegerPostfix(schema TypeSchema) string {
+
+	if schema.GetSchemaType() == SCHEMATYPE_INTEGER && schema.(*IntegerSchema).IsBig() {
+		return "n"
+	}
+	return ""
+}
+
+/*
+	Returns an expression which converts the given deserialized [value] to the type used for the given [schema].
+	Arbitrary-precision integers are serialized as strings (or numbers, by other producers) and must be made into BigInts,
+	binary content is serialized as base64, and objects arrive as plain maps, which are deserialized into their own class (in [module]).
+*/
+func getJSDeserializedValue(schema TypeSchema, value string, module string) string {
+
+	var conversion, className string
+	var isArray bool
+
+	if schema.GetSchemaType() == SCHEMATYPE_ARRAY {
+		schema = schema.(*ArraySchema).Items
+		isArray = true
+	}
+
+	if schema.GetSchemaType() == SCHEMATYPE_OBJECT {
+
+		className = fmt.Sprintf("%s.%s", module, ToCamelCase(schema.GetTitle()))
+		if !isArray {
+			return fmt.Sprintf("(%s == null ? %s : %s.deserializeFrom(%s))", value, value, className, value)
+		}
+
+		// items of an array may be null too.
+		conversion = fmt.Sprintf("item == null ? item : %s.deserializeFrom(item)", className)
+	} else if getJSIntegerPostfix(schema) != "" {
+		conversion = "BigInt(item)"
+	} else if isJSBinary(schema) {
+		conversion = "Uint8Array.from(atob(item), function(character) { return character.charCodeAt(0) })"
+	} else {
+		return value
+	}
+
+	if isArray {
+		return fmt.Sprintf("(%s == null ? %s : %s.map(function(item) { return %s }))", value, value, value, conversion)
+	}
+	return fmt.Sprintf("(%s == null ? %s : (function(item) { return %s })(%s))", value, value, conversion, value)
+}
+
+func isJSBinary(schema TypeSchema) bool {
+	return schema.GetSchemaType() == SCHEMATYPE_STRING && schema.(*StringSchema).IsBinary()
+}
+
+func getJSTypeFromSchemaType(schemaType SchemaType) string {
+
+	switch schemaType {
+	case SCHEMATYPE_NUMBER:
+		fallthrough
+	case SCHEMATYPE_INTEGER:
+		return "number"
+	case SCHEMATYPE_ARRAY:
+		fallthrough
+	case SCHEMATYPE_OBJECT:
+		return "object"
+	case SCHEMATYPE_STRING:
+		return "string"
+	case SCHEMATYPE_BOOLEAN:
+		return "boolean"
+	}
+
+	return "object"
+}
@@ -0,0 +1,158 @@
+package presilo
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+	Deserializes a map holding nested objects with the JS generated for the conformance "objects" and "arrays" schemas,
+	then validates them; nested objects have to be deserialized into their own classes for their setters to accept them.
+*/
+const jsDeserializeThenValidate = `
+var conformance = require("./conformance/index.js")
+
+var objects = conformance.Objects.deserializeFrom({
+	"id": 1,
+	"password": "correct horse",
+	"recoveryCodes": ["123456"],
+	"legacy": null,
+	"owner": {"name": "Alice", "email": "alice@example.com"},
+	"home": null,
+	"work": {"street": "Main", "city": "Springfield"}
+})
+objects.validate()
+
+var arrays = conformance.Arrays.deserializeFrom({"tags": ["a"], "matrix": [[1], [2]], "points": [{"x": 1, "y": 2}, null]})
+console.log(arrays.points[0] instanceof conformance.Point)
+
+objects.work.city = ""
+try {
+	objects.validate()
+	console.log("no violations")
+} catch(e) {
+	console.log(e.message)
+}
+`
+
+/*
+	Breaks the uniqueItems constraints of the conformance "arrays" schema, with a duplicated string and a duplicated (nested) array,
+	which a Set alone wouldn't notice.
+*/
+const jsDuplicateItems = `
+var conformance = require("./conformance/index.js")
+
+var arrays = new conformance.Arrays()
+try {
+	arrays.setTags(["a", "b", "a"])
+} catch(e) {
+	console.log(e.message)
+}
+
+arrays.setMatrix([[1, 2], [1, 3]])
+arrays.tags = ["a", "a"]
+arrays.matrix = [[1, 2], [1, 2]]
+
+try {
+	arrays.validate()
+	console.log("no violations")
+} catch(e) {
+	console.log(e.message)
+}
+`
+
+func TestJSDeserializeThenValidate(test *testing.T) {
+
+	var output string
+
+	output = runJSConformance(test, []string{"arrays", "objects"}, jsDeserializeThenValidate)
+	if output != "true\nwork.city: minLength: was shorter than allowable minimum (1), value: " {
+		test.Errorf("Expected nested objects of their own classes, and the nested object's violation, but got:\n%s", output)
+	}
+}
+
+func TestJSDuplicateItems(test *testing.T) {
+
+	var output, expected string
+
+	expected = strings.Join([]string{
+		"Property 'a,b,a' has duplicate items.",
+		"tags: uniqueItems: has duplicate items, value: a,a",
+		"matrix: uniqueItems: has duplicate items, value: 1,2,1,2",
+	}, "\n")
+
+	output = runJSConformance(test, []string{"arrays"}, jsDuplicateItems)
+	if output != expected {
+		test.Errorf("Expected each array with duplicate items to be rejected, but got:\n%s", output)
+	}
+}
+
+/*
+	Generates the JS for each of the given conformance [schemaNames] into one "conformance" directory, with an index of them all,
+	runs the given [script] beside it with node, and returns what it prints (without the final newline).
+	Skips the test if there's no node to run it with.
+*/
+func runJSConformance(test *testing.T, schemaNames []string, script string) string {
+
+	var context, parsed *SchemaParseContext
+	var files map[string]string
+	var command *exec.Cmd
+	var directory, path string
+	var output []byte
+	var err error
+
+	_, err = exec.LookPath("node")
+	if err != nil {
+		test.Skip("node isn't installed")
+	}
+
+	// every schema goes in one context, so that the index loads them all.
+	for _, schemaName := range schemaNames {
+
+		parsed, err = parseConformanceSchema(filepath.Join(conformanceDirectory, "schemas", schemaName+".json"))
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		if context == nil {
+			context = parsed
+			continue
+		}
+
+		for id, definition := range parsed.SchemaDefinitions {
+			context.SchemaDefinitions[id] = definition
+		}
+	}
+
+	files, err = GenerateCode(context, "conformance", "js", "\t", false, true, GeneratorOptions{})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	directory = test.TempDir()
+
+	err = writeGoldenFiles(filepath.Join(directory, "conformance"), files)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	path = filepath.Join(directory, "check.js")
+
+	err = os.WriteFile(path, []byte(script), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	command = exec.Command("node", path)
+	command.Dir = directory
+
+	output, err = command.CombinedOutput()
+	if err != nil {
+		test.Fatalf("Running the generated code failed: %s\n%s", err.Error(), output)
+	}
+
+	return strings.TrimSuffix(string(output), "\n")
+}
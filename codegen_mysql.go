@@ -16,14 +16,22 @@ import (
   - Provides a best guess for string column length based on constraints for min/max length
   - Does not provide a primary key!
   - Does not support regex constraints.
+  - Does not support "not" constraints.
   - Uses 'bit' to represent booleans, with 0 = true, 1 = false.
+  - Uses 'bigint' for 64-bit integers, and 'decimal(65,0)' for arbitrary-precision integers
+    (which therefore cannot exceed 65 digits).
+  - Uses 'decimal(p,s)' for decimal numbers.
+  - Uses 'varbinary' for binary content bounded to 65535 bytes, and the smallest fitting blob type otherwise.
 */
-func GenerateMySQL(schema *ObjectSchema, module string, tabstyle string) string {
+func GenerateMySQL(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
 
 	var buffer *BufferedFormatString
 
 	buffer = NewBufferedFormatString(tabstyle)
 	generateMysqlCreate(schema, module, buffer)
+	buffer.Print("\n")
+	generateProtectedRegion(ToCamelCase(schema.GetTitle()), "--", buffer)
+	buffer.Print("\n")
 
 	return buffer.String()
 }
@@ -66,6 +74,10 @@ func generateMysqlCreate(schema *ObjectSchema, module string, buffer *BufferedFo
 			}
 		}
 
+		if getModelRule(subschema, MODELRULE_NOT) != nil {
+			fmt.Println("Schema contains a 'not' constraint, which has no definite analogue in MySQL.")
+		}
+
 		if firstProperty {
 			firstProperty = false
 		} else {
@@ -88,6 +100,8 @@ func generateMysqlCreate(schema *ObjectSchema, module string, buffer *BufferedFo
 		}
 	}
 
+	generateMySQLConditions(schema, buffer)
+
 	buffer.AddIndentation(-1)
 	buffer.Print("\n);")
 
@@ -95,8 +109,57 @@ func generateMysqlCreate(schema *ObjectSchema, module string, buffer *BufferedFo
 	buffer.Print("\n\n")
 }
 
+/*
+	Generates table-level CHECK constraints for dependentRequired properties.
+	Conditional schemas (if/then/else, dependentSchemas) have no definite analogue, and are skipped.
+*/
+func generateMySQLConditions(schema *ObjectSchema, buffer *BufferedFormatString) {
+
+	var column, dependencyColumn string
+
+	if len(getConditionalSchemas(schema)) > 0 {
+		fmt.Println("Schema contains conditional subschemas, which have no definite analogue in MySQL.")
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		column = getMySQLColumnName(propertyName, schema.Properties[propertyName])
+		if len(column) == 0 {
+			continue
+		}
+
+		for _, dependency := range schema.DependentRequired[propertyName] {
+
+			dependencyColumn = getMySQLColumnName(dependency, schema.Properties[dependency])
+			if len(dependencyColumn) == 0 {
+				continue
+			}
+
+			buffer.Printf(",\nCHECK(%s IS NULL OR %s IS NOT NULL)", column, dependencyColumn)
+		}
+	}
+}
+
+/*
+	Returns the name of the column used to store the given property,
+	or an empty string if the property has no column (as with arrays).
+*/
+func getMySQLColumnName(propertyName string, schema TypeSchema) string {
+
+	switch schema.GetSchemaType() {
+	case SCHEMATYPE_OBJECT:
+		return propertyName + "__id"
+	case SCHEMATYPE_ARRAY:
+		return ""
+	}
+
+	return propertyName
+}
+
 func generateMySQLBoolColumn(name string, required bool, schema *BooleanSchema, buffer *BufferedFormatString) {
 
+	var enum *ModelRule
+
 	buffer.Printf("\t%s bit", name)
 	buffer.AddIndentation(1)
 
@@ -105,11 +168,30 @@ func generateMySQLBoolColumn(name string, required bool, schema *BooleanSchema,
 	}
 
 	buffer.Printf("\nCHECK(%s = 0 OR %s = 1)", name, name)
+
+	// remember that 0 is true.
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		if enum.Value.([]interface{})[0].(bool) {
+			buffer.Printf(",\nCHECK(%s = 0)", name)
+		} else {
+			buffer.Printf(",\nCHECK(%s = 1)", name)
+		}
+	}
+
 	buffer.AddIndentation(-1)
 }
 
 func generateMySQLStringColumn(name string, required bool, schema *StringSchema, buffer *BufferedFormatString) {
 
+	var enum *ModelRule
+
+	if schema.IsBinary() {
+		generateMySQLBinaryColumn(name, required, schema, buffer)
+		return
+	}
+
 	buffer.Printf("%s nvarchar(128)", name)
 	buffer.AddIndentation(1)
 
@@ -117,24 +199,54 @@ func generateMySQLStringColumn(name string, required bool, schema *StringSchema,
 		generateMySQLRequiredConstraint(buffer)
 	}
 
-	if schema.MinLength != nil {
-		generateMySQLRangeCheck(*schema.MinLength, name, "%d", false, "<", "", buffer)
+	generateMySQLRangeChecks(schema, MODELSUBJECT_LENGTH, "char_length("+name+")", buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateMySQLEnumCheck(schema, enum, "'", "'", buffer)
 	}
 
-	if schema.MaxLength != nil {
-		generateMySQLRangeCheck(*schema.MaxLength, name, "%d", false, ">", "", buffer)
+	buffer.AddIndentation(-1)
+}
+
+/*
+	Generates a column for base64-encoded binary content, which is stored as raw bytes.
+*/
+func generateMySQLBinaryColumn(name string, required bool, schema *StringSchema, buffer *BufferedFormatString) {
+
+	var columnType string
+
+	switch {
+	case schema.MaxByteLength != nil && *schema.MaxByteLength <= 65535:
+		columnType = fmt.Sprintf("varbinary(%d)", *schema.MaxByteLength)
+	case schema.MaxByteLength != nil && *schema.MaxByteLength <= 16777215:
+		columnType = "mediumblob"
+	default:
+		columnType = "longblob"
 	}
 
-	if schema.Enum != nil {
-		generateMySQLEnumCheck(schema, schema.GetEnum(), "'", "'", buffer)
+	buffer.Printf("%s %s", name, columnType)
+	buffer.AddIndentation(1)
+
+	if required {
+		generateMySQLRequiredConstraint(buffer)
 	}
 
+	generateMySQLRangeChecks(schema, MODELSUBJECT_BYTE_LENGTH, "length("+name+")", buffer)
+
 	buffer.AddIndentation(-1)
 }
 
 func generateMySQLIntegerColumn(name string, required bool, schema *IntegerSchema, buffer *BufferedFormatString) {
 
-	buffer.Printf("%s int", name)
+	switch schema.GetWidth() {
+	case INTEGERWIDTH_64:
+		buffer.Printf("%s bigint", name)
+	case INTEGERWIDTH_BIG:
+		buffer.Printf("%s decimal(65,0)", name)
+	default:
+		buffer.Printf("%s int", name)
+	}
 	buffer.AddIndentation(1)
 
 	if required {
@@ -147,7 +259,11 @@ func generateMySQLIntegerColumn(name string, required bool, schema *IntegerSchem
 
 func generateMySQLNumberColumn(name string, required bool, schema *NumberSchema, buffer *BufferedFormatString) {
 
-	buffer.Printf("%s float", name)
+	if schema.IsDecimal() {
+		buffer.Printf("%s decimal(%d,%d)", name, schema.GetPrecision(), schema.GetScale())
+	} else {
+		buffer.Printf("%s float", name)
+	}
 	buffer.AddIndentation(1)
 
 	if required {
@@ -198,12 +314,14 @@ func generateMySQLRequiredConstraint(buffer *BufferedFormatString) {
 /*
 	Generates code which throws an error if the given [parameter]'s value is not contained in the given [validValues].
 */
-func generateMySQLEnumCheck(schema interface{}, enumValues []interface{}, prefix string, postfix string, buffer *BufferedFormatString) {
+func generateMySQLEnumCheck(schema interface{}, rule *ModelRule, prefix string, postfix string, buffer *BufferedFormatString) {
 
+	var enumValues []interface{}
 	var schemaName string
 	var length int
 
 	schemaName = ToJavaCase((schema.(TypeSchema)).GetTitle())
+	enumValues = rule.Value.([]interface{})
 	length = len(enumValues)
 
 	if length <= 0 {
@@ -222,32 +340,28 @@ func generateMySQLEnumCheck(schema interface{}, enumValues []interface{}, prefix
 
 func generateMySQLNumericConstraints(name string, schema NumericSchemaType, buffer *BufferedFormatString) {
 
-	if schema.HasMinimum() {
-		generateMySQLRangeCheck(schema.GetMinimum(), "value", schema.GetConstraintFormat(), schema.IsExclusiveMinimum(), "<=", "<", buffer)
-	}
+	var enum, multiple *ModelRule
 
-	if schema.HasMaximum() {
-		generateMySQLRangeCheck(schema.GetMaximum(), "value", schema.GetConstraintFormat(), schema.IsExclusiveMaximum(), ">=", ">", buffer)
-	}
+	generateMySQLRangeChecks(schema.(TypeSchema), MODELSUBJECT_VALUE, name, buffer)
 
-	if schema.HasEnum() {
-		generateMySQLEnumCheck(schema, schema.GetEnum(), "", "", buffer)
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateMySQLEnumCheck(schema, enum, "", "", buffer)
 	}
 
-	if schema.HasMultiple() {
-		buffer.Printf("\nCHECK(mod(%s, %v) = 0)", name, schema.GetMultiple())
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
+		buffer.Printf("\nCHECK(mod(%s, %v) = 0)", name, multiple.Value)
 	}
 }
 
-func generateMySQLRangeCheck(value interface{}, reference string, format string, exclusive bool, comparator, exclusiveComparator string, buffer *BufferedFormatString) {
-
-	var compareString string
+/*
+	Generates a CHECK for every range rule of the given [schema] on the given [subject], comparing [reference] to each bound.
+	CHECKs state what must hold, so each uses the rule's requirement rather than its violation.
+*/
+func generateMySQLRangeChecks(schema TypeSchema, subject ModelSubject, reference string, buffer *BufferedFormatString) {
 
-	if exclusive {
-		compareString = exclusiveComparator
-	} else {
-		compareString = comparator
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, subject) {
+		buffer.Printf("\nCHECK(%s %s %v)", reference, rule.Requirement, rule.Value)
 	}
-
-	buffer.Printf("\nCHECK(%s %s %v)", reference, compareString, value)
 }
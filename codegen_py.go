@@ -6,23 +6,33 @@ import (
 	"strings"
 )
 
-func GeneratePython(schema *ObjectSchema, module string, tabstyle string) string {
+func GeneratePython(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
 
 	var ret *BufferedFormatString
+	var model *ModelType
+
+	warnUncheckedNots(schema, "py", "Python")
 
 	ret = NewBufferedFormatString(tabstyle)
+	model = NewModelType(schema)
 
 	generatePythonImports(schema, ret)
 	ret.Printfln("")
-	generatePythonSignature(schema, ret)
+	generatePythonSignature(model, ret)
+	ret.Printfln("")
+	generatePythonConstructor(model, options, ret)
+	ret.Printfln("")
+	generatePythonDeserializer(model, options, ret)
 	ret.Printfln("")
-	generatePythonConstructor(schema, ret)
+	generatePythonSerializer(schema, options, ret)
 	ret.Printfln("")
-	generatePythonDeserializer(schema, ret)
+	generatePythonFunctions(model, options, ret)
 	ret.Printfln("")
-	generatePythonSerializer(schema, ret)
+	generatePythonConditions(schema, options, ret)
 	ret.Printfln("")
-	generatePythonFunctions(schema, ret)
+	generatePythonValidation(model, options, ret)
+	ret.Printfln("")
+	generateProtectedRegion(ToCamelCase(schema.GetTitle()), "#", ret)
 	ret.Printfln("")
 
 	return ret.String()
@@ -36,6 +46,51 @@ func ValidatePythonModule(module string) bool {
 	return err == nil && matched
 }
 
+/*
+	Returns the path of the file the given [schema] is written to; a snake_case module named after its class.
+	The target path is the package itself, so there are no directories.
+*/
+func GetPythonFileName(schema *ObjectSchema, module string) string {
+	return ToSnakeCase(schema.GetTitle())
+}
+
+/*
+	Generates a relative import of the class of each of the given [dependencies], from its own module in the same package.
+*/
+func GeneratePythonImports(schema *ObjectSchema, dependencies []*ObjectSchema, module string, tabstyle string) string {
+
+	var buffer *BufferedFormatString
+
+	buffer = NewBufferedFormatString(tabstyle)
+
+	for _, dependency := range dependencies {
+		buffer.Printfln("from .%s import %s", GetPythonFileName(dependency, module), ToCamelCase(dependency.GetTitle()))
+	}
+
+	buffer.Print("\n")
+	return buffer.String()
+}
+
+/*
+	Generates the "__init__.py" of the package, which imports every one of the given [schemas], so that they can be imported from the package itself.
+*/
+func GeneratePythonIndex(schemas []*ObjectSchema, module string, tabstyle string) (string, string) {
+
+	var buffer *BufferedFormatString
+	var names []string
+
+	buffer = NewBufferedFormatString(tabstyle)
+
+	for _, schema := range schemas {
+
+		buffer.Printfln("from .%s import %s", GetPythonFileName(schema, module), ToCamelCase(schema.GetTitle()))
+		names = append(names, fmt.Sprintf("\"%s\"", ToCamelCase(schema.GetTitle())))
+	}
+
+	buffer.Printf("\n__all__ = [%s]\n", strings.Join(names, ", "))
+	return "__init__", buffer.String()
+}
+
 func generatePythonImports(schema *ObjectSchema, buffer *BufferedFormatString) {
 
 	buffer.Printfln("import string")
@@ -44,51 +99,93 @@ func generatePythonImports(schema *ObjectSchema, buffer *BufferedFormatString) {
 	if containsRegexpMatch(schema) {
 		buffer.Printfln("import re")
 	}
+
+	if containsDeprecation(schema) {
+		buffer.Printfln("import warnings")
+	}
+
+	if containsDecimal(schema) {
+		buffer.Printfln("from decimal import Decimal")
+	}
+
+	if containsBinary(schema) {
+		buffer.Printfln("import base64")
+	}
 }
 
-func generatePythonSignature(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generatePythonSignature(model *ModelType, buffer *BufferedFormatString) {
 
+	var writeOnly []string
 	var description string
 
-	description = schema.GetDescription()
+	description = model.Description
 
 	if len(description) != 0 {
-		buffer.Printfln("'''\n%s\n'''\n", schema.GetDescription())
+		buffer.Printfln("'''\n%s\n'''\n", description)
 	}
 
-	buffer.Printfln("class %s(object):", ToCamelCase(schema.Title))
+	buffer.Printfln("class %s(object):", ToCamelCase(model.Name))
 	buffer.AddIndentation(1)
+
+	// writeOnly fields are listed so that the serializer can skip them.
+	for _, field := range model.GetWriteOnlyFields() {
+		writeOnly = append(writeOnly, fmt.Sprintf("\"%s\"", ToSnakeCase(field.Name)))
+	}
+
+	if len(writeOnly) > 0 {
+
+		buffer.Printf("\nwrite_only_fields = [%s]\n", strings.Join(writeOnly, ", "))
+	}
 }
 
-func generatePythonConstructor(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generatePythonConstructor(model *ModelType, options GeneratorOptions, buffer *BufferedFormatString) {
 
+	var schema *ObjectSchema
 	var declarations, setters []string
+	var constFields []*ModelField
 	var propertyName string
 	var toWrite string
 
-	if(len(schema.RequiredProperties) <= 0) {
+	schema = model.Schema
+	constFields = model.GetConstFields()
+
+	if len(model.ConstructorFields) <= 0 && len(constFields) <= 0 && !schema.IsDeprecated() {
 		return
 	}
 
 	buffer.Print("\ndef __init__(self")
 
 	// required properties
-	for _, propertyName = range schema.RequiredProperties {
+	for _, field := range model.ConstructorFields {
 
-		propertyName = ToSnakeCase(propertyName)
+		propertyName = ToSnakeCase(field.Name)
 		declarations = append(declarations, propertyName)
 
 		toWrite = fmt.Sprintf("\nself.set_%s(%s)", ToSnakeCase(propertyName), propertyName)
 		setters = append(setters, toWrite)
 	}
 
-	buffer.Print(", ")
-	buffer.Print(strings.Join(declarations, ", "))
+	if len(declarations) > 0 {
+		buffer.Print(", ")
+		buffer.Print(strings.Join(declarations, ", "))
+	}
 	buffer.Print("):")
 
 	// use setters
 	buffer.AddIndentation(1)
 
+	generatePythonDeprecation(schema, ToCamelCase(schema.Title), buffer)
+
+	// const fields are fixed, and have no setter.
+	for _, field := range constFields {
+
+		if isDecimal(field.Schema) {
+			buffer.Printf("\nself.%s = Decimal(\"%s\")", getPythonFieldName(field.Name, options), field.Const)
+			continue
+		}
+		buffer.Printf("\nself.%s = %s", getPythonFieldName(field.Name, options), getConstLiteral(field.Const, "True", "False"))
+	}
+
 	for _, setter := range setters {
 		buffer.Print(setter)
 	}
@@ -96,7 +193,7 @@ func generatePythonConstructor(schema *ObjectSchema, buffer *BufferedFormatStrin
 	buffer.AddIndentation(-1)
 }
 
-func generatePythonDeserializer(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generatePythonDeserializer(model *ModelType, options GeneratorOptions, buffer *BufferedFormatString) {
 
 	var property TypeSchema
 	var ctorArguments []string
@@ -104,7 +201,7 @@ func generatePythonDeserializer(schema *ObjectSchema, buffer *BufferedFormatStri
 	var className string
 	var propertyName, casedPropertyName string
 
-	className = ToCamelCase(schema.GetTitle())
+	className = ToCamelCase(model.Name)
 
 	buffer.Printf("\n@staticmethod")
 	buffer.Printf("\ndef deserialize_from(map):")
@@ -113,35 +210,36 @@ func generatePythonDeserializer(schema *ObjectSchema, buffer *BufferedFormatStri
 	// use constructor
 	buffer.Printf("\nret = %s(", className)
 
-	for _, propertyName = range schema.RequiredProperties {
+	for _, field := range model.ConstructorFields {
 
-		argument = fmt.Sprintf("map[\"%s\"]", ToJavaCase(propertyName))
-		ctorArguments = append(ctorArguments, argument)
+		argument = fmt.Sprintf("map[\"%s\"]", field.Name)
+		ctorArguments = append(ctorArguments, getPythonDeserializedValue(field.Schema, argument))
 	}
 
 	buffer.Printf("%s)", strings.Join(ctorArguments, ", "))
 
 	// misc setters
 	buffer.Printf("\n")
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
+	for _, field := range model.Fields {
 
-		property = schema.Properties[propertyName]
+		property = field.Schema
+		propertyName = field.Name
 		casedPropertyName = fmt.Sprintf("map[\"%s\"]", propertyName)
 
-		// if it's already set, skip it.
-		if arrayContainsString(schema.RequiredProperties, propertyName) {
+		// if it's already set (or can only have one value), skip it.
+		if model.IsConstructorField(field) || field.IsConst {
 			continue
 		}
 
-		// if it's constrained, use the setter
-		if property.HasConstraints() {
+		// if it's constrained (or a decimal, which the setter converts), or every field has accessors, use the setter (readOnly fields have none)
+		if (property.HasConstraints() || isDecimal(property) || options.AllAccessors) && field.Settable {
 
-			buffer.Printf("\nret.set_%s(%s)", ToSnakeCase(propertyName), casedPropertyName)
+			buffer.Printf("\nret.set_%s(%s)", ToSnakeCase(propertyName), getPythonDeserializedValue(property, casedPropertyName))
 			continue
 		}
 
 		// otherwise set.
-		buffer.Printf("\nret.%s = %s", propertyName, casedPropertyName)
+		buffer.Printf("\nret.%s = %s", getPythonFieldName(propertyName, options), getPythonDeserializedValue(property, casedPropertyName))
 	}
 
 	buffer.Printf("\nreturn ret")
@@ -149,24 +247,79 @@ func generatePythonDeserializer(schema *ObjectSchema, buffer *BufferedFormatStri
 	buffer.Printf("\n")
 }
 
-func generatePythonSerializer(schema *ObjectSchema, buffer *BufferedFormatString) {
+/*
+	Returns an expression which converts the given deserialized [value] to the type used for the given [schema].
+	Binary content arrives as base64, decimal items as floats (single decimals are converted by their setters),
+	and objects as dicts, which are deserialized into their own class.
+*/
+func getPythonDeserializedValue(schema TypeSchema, value string) string {
 
+	var conversion string
+
+	if schema.GetSchemaType() == SCHEMATYPE_STRING && schema.(*StringSchema).IsBinary() {
+		return fmt.Sprintf("(base64.b64decode(%s) if %s is not None else None)", value, value)
+	}
+
+	if schema.GetSchemaType() == SCHEMATYPE_OBJECT {
+		return fmt.Sprintf("(%s.deserialize_from(%s) if %s is not None else None)", ToCamelCase(schema.GetTitle()), value, value)
+	}
+
+	if schema.GetSchemaType() != SCHEMATYPE_ARRAY {
+		return value
+	}
+
+	schema = schema.(*ArraySchema).Items
+
+	if isDecimal(schema) {
+		conversion = "Decimal(str(item))"
+	} else if schema.GetSchemaType() == SCHEMATYPE_STRING && schema.(*StringSchema).IsBinary() {
+		conversion = "base64.b64decode(item)"
+	} else if schema.GetSchemaType() == SCHEMATYPE_OBJECT {
+		conversion = fmt.Sprintf("(%s.deserialize_from(item) if item is not None else None)", ToCamelCase(schema.GetTitle()))
+	} else {
+		return value
+	}
+
+	return fmt.Sprintf("([%s for item in %s] if %s is not None else None)", conversion, value, value)
+}
+
+func generatePythonSerializer(schema *ObjectSchema, options GeneratorOptions, buffer *BufferedFormatString) {
+
+	var objectDefault string
+
+	objectDefault = "dict((k, v) for k, v in o.__dict__.items() if k not in getattr(o, \"write_only_fields\", []))"
+
+	// private fields are serialized without their leading underscore.
+	if options.AllAccessors {
+		objectDefault = "dict((k.lstrip(\"_\"), v) for k, v in o.__dict__.items() if k.lstrip(\"_\") not in getattr(o, \"write_only_fields\", []))"
+	}
+
+	// json can't write Decimals, and floats would round them, so they're written as strings.
+	if containsDecimal(schema) {
+		objectDefault = "str(o) if isinstance(o, Decimal) else " + objectDefault
+	}
+
+	// nor can it write bytes, which are written as base64.
+	if containsBinary(schema) {
+		objectDefault = "base64.b64encode(o).decode(\"ascii\") if isinstance(o, bytes) else " + objectDefault
+	}
 
 	buffer.Printf("\ndef to_json(self):")
 	buffer.AddIndentation(1)
-	buffer.Printf("\nreturn json.dumps(self, default=lambda o: o.__dict__, sort_keys=True, indent=4)")
+	buffer.Printf("\nreturn json.dumps(self, default=lambda o: %s, sort_keys=True, indent=4)", objectDefault)
 	buffer.AddIndentation(-1)
 }
 
-func generatePythonFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generatePythonFunctions(model *ModelType, options GeneratorOptions, buffer *BufferedFormatString) {
 
 	var subschema TypeSchema
-	var propertyName, snakeName, description string
+	var snakeName, fieldName, description string
 
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
+	for _, field := range model.Fields {
 
-		subschema = schema.Properties[propertyName]
-		snakeName = ToSnakeCase(propertyName)
+		subschema = field.Schema
+		snakeName = ToSnakeCase(field.Name)
+		fieldName = getPythonFieldName(field.Name, options)
 		description = subschema.GetDescription()
 
 		// getter
@@ -183,9 +336,15 @@ func generatePythonFunctions(schema *ObjectSchema, buffer *BufferedFormatString)
 			buffer.Print("\n'''")
 		}
 
-		buffer.Printf("\nreturn self.%s\n", snakeName)
+		generatePythonDeprecation(subschema, snakeName, buffer)
+		buffer.Printf("\nreturn self.%s\n", fieldName)
 		buffer.AddIndentation(-1)
 
+		// readOnly and const fields are never set by consumers, no setter.
+		if !field.Settable {
+			continue
+		}
+
 		// setter
 		buffer.Printf("\ndef set_%s(self, value):", snakeName)
 		buffer.AddIndentation(1)
@@ -200,126 +359,500 @@ func generatePythonFunctions(schema *ObjectSchema, buffer *BufferedFormatString)
 			buffer.Print("\n'''")
 		}
 
-		switch subschema.GetSchemaType() {
-		case SCHEMATYPE_STRING:
-			generatePythonStringSetter(subschema.(*StringSchema), buffer)
-		case SCHEMATYPE_INTEGER:
-			fallthrough
-		case SCHEMATYPE_NUMBER:
-			generatePythonNumericSetter(subschema.(NumericSchemaType), buffer)
-		case SCHEMATYPE_OBJECT:
-			generatePythonObjectSetter(subschema.(*ObjectSchema), buffer)
-		case SCHEMATYPE_ARRAY:
-			generatePythonArraySetter(subschema.(*ArraySchema), buffer)
+		generatePythonDeprecation(subschema, snakeName, buffer)
+
+		// only a setter can be given null to check; everything else checks values once it knows they're there.
+		if isNullRefused(subschema) {
+			generatePythonNullCheck(buffer)
+		}
+
+		generatePythonChecks(subschema, failWithStatement, buffer)
+
+		buffer.Printf("\nself.%s = value\n", fieldName)
+		buffer.AddIndentation(-1)
+	}
+}
+
+/*
+	Generates a "_collect_conditional_violations" method for the given schema, which checks constraints that span multiple properties;
+	if/then/else, dependentRequired, and dependentSchemas. collect_violations() adds whatever it finds.
+	The "if" schema is generated as a method which raises on the first violation found, since it only decides
+	which of "then" or "else" applies. Each other conditional schema is generated as its own method,
+	which adds a violation for each field (beneath the given prefix) which doesn't meet it.
+*/
+func generatePythonConditions(schema *ObjectSchema, options GeneratorOptions, buffer *BufferedFormatString) {
+
+	if !schema.HasConditions() {
+		return
+	}
+
+	buffer.Print("\ndef _collect_conditional_violations(self, prefix, violations):")
+	buffer.AddIndentation(1)
+
+	buffer.Print("\n'''")
+	buffer.AddIndentation(1)
+	buffer.Printf("\nAdds a description of each constraint of this %s which spans more than one field, and which it violates, to [violations].", ToCamelCase(schema.Title))
+	buffer.AddIndentation(-1)
+	buffer.Print("\n'''")
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		buffer.Print("\ntry:")
+		buffer.AddIndentation(1)
+		buffer.Print("\nself._validate_if()\nmatched = True")
+		buffer.AddIndentation(-1)
+		buffer.Print("\nexcept Exception:")
+		buffer.AddIndentation(1)
+		buffer.Print("\nmatched = False")
+		buffer.AddIndentation(-1)
+		buffer.Print("\n")
+
+		if schema.Then != nil {
+
+			buffer.Print("\nif(matched):")
+			buffer.AddIndentation(1)
+			buffer.Print("\nself._collect_then_condition_violations(prefix, violations)")
+			buffer.AddIndentation(-1)
+
+			if schema.Else != nil {
+				buffer.Print("\nelse:")
+				buffer.AddIndentation(1)
+				buffer.Print("\nself._collect_else_condition_violations(prefix, violations)")
+				buffer.AddIndentation(-1)
+			}
+		} else {
+			buffer.Print("\nif(not matched):")
+			buffer.AddIndentation(1)
+			buffer.Print("\nself._collect_else_condition_violations(prefix, violations)")
+			buffer.AddIndentation(-1)
+		}
+		buffer.Print("\n")
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		buffer.Printf("\nif(getattr(self, \"%s\", None) != None):", getPythonFieldName(propertyName, options))
+		buffer.AddIndentation(1)
+
+		for _, dependency := range schema.DependentRequired[propertyName] {
+
+			buffer.Printf("\nif(getattr(self, \"%s\", None) == None):", getPythonFieldName(dependency, options))
+			buffer.AddIndentation(1)
+			buffer.Printf("\nviolations.append(prefix + \"%s\")", getDependentRequiredViolationText(dependency, propertyName))
+			buffer.AddIndentation(-1)
+		}
+
+		if _, found := schema.DependentSchemas[propertyName]; found {
+			buffer.Printf("\nself._collect_dependent_%s_condition_violations(prefix, violations)", ToSnakeCase(propertyName))
 		}
 
-		buffer.Printf("\nself.%s = value\n", snakeName)
 		buffer.AddIndentation(-1)
+		buffer.Print("\n")
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n")
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		generatePythonCondition(schema, schema.If, "if", false, options, buffer)
+
+		if schema.Then != nil {
+			generatePythonCondition(schema, schema.Then, "then", true, options, buffer)
+		}
+		if schema.Else != nil {
+			generatePythonCondition(schema, schema.Else, "else", true, options, buffer)
+		}
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		if condition, found := schema.DependentSchemas[propertyName]; found {
+			generatePythonCondition(schema, condition, "dependent_"+ToSnakeCase(propertyName), true, options, buffer)
+		}
+	}
+}
+
+/*
+	Generates a "validate" method for the given schema, which checks every constraint of every field (and of every object they hold),
+	and raises a ValueError which lists every violation, rather than stopping at the first.
+	The work is done by "collect_violations", which objects holding this one call with their own path.
+*/
+func generatePythonValidation(model *ModelType, options GeneratorOptions, buffer *BufferedFormatString) {
+
+	var schema *ObjectSchema
+	var fields []*ModelField
+
+	schema = model.Schema
+	fields = getValidatedFields(model, "py")
+
+	buffer.Print("\ndef validate(self):")
+	buffer.AddIndentation(1)
+
+	buffer.Print("\n'''")
+	buffer.AddIndentation(1)
+	buffer.Printf("\nChecks every constraint of this %s, and of every object it holds,", ToCamelCase(schema.Title))
+	buffer.Print("\nand raises a ValueError which lists every violation.")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n'''")
+
+	buffer.Print("\nviolations = self.collect_violations(\"\", [])")
+	buffer.Print("\nif(len(violations) > 0):")
+	buffer.AddIndentation(1)
+	buffer.Print("\nraise ValueError(\"\\n\".join(violations))")
+	buffer.AddIndentation(-2)
+	buffer.Print("\n")
+
+	buffer.Print("\ndef collect_violations(self, path, violations):")
+	buffer.AddIndentation(1)
+
+	buffer.Print("\n'''")
+	buffer.AddIndentation(1)
+	buffer.Printf("\nAdds a description of every constraint this %s violates to [violations], and returns them.", ToCamelCase(schema.Title))
+	buffer.Print("\nEach is described with the path of the field which violated it, beneath the given [path] of this object.")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n'''")
+
+	if len(fields) > 0 || schema.HasConditions() {
+		buffer.Print("\nprefix = path + \".\" if path else \"\"")
+	}
+
+	for _, field := range fields {
+		buffer.Printf("\nself._collect_%s_violations(prefix + \"%s\", getattr(self, \"%s\", None), violations)", ToSnakeCase(field.Name), sanitizeQuotedString(field.Name), getPythonFieldName(field.Name, options))
+	}
+
+	if schema.HasConditions() {
+		buffer.Print("\nself._collect_conditional_violations(prefix, violations)")
+	}
+
+	buffer.Print("\nreturn violations")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n")
+
+	for _, field := range fields {
+		generatePythonValueValidation(field.Schema, chooseViolation(field.Schema, getPythonViolation, getPythonWriteOnlyViolation), ToSnakeCase(field.Name), field.Required, buffer)
 	}
 }
 
-func generatePythonStringSetter(schema *StringSchema, buffer *BufferedFormatString) {
+/*
+	Generates a method which checks a value of the given [subschema], and anything it holds,
+	named after the given (snake-cased) [name]. Each field (and the items of each array) has its own method,
+	so that checking the items of an array doesn't clobber the array's own 'value' and 'path'.
+	A value which is missing is only a violation if it's [required].
+*/
+func generatePythonValueValidation(subschema TypeSchema, failure checkFailure, name string, required bool, buffer *BufferedFormatString) {
 
-	if !schema.Nullable {
-		generatePythonNullCheck(buffer)
+	var items TypeSchema
+
+	buffer.Printf("\ndef _collect_%s_violations(self, path, value, violations):", name)
+	buffer.AddIndentation(1)
+
+	buffer.Print("\nif(value == None):")
+	buffer.AddIndentation(1)
+
+	if required {
+		buffer.Print("\nviolations.append(path + \": required: is required\")")
 	}
 
-	if schema.MinLength != nil {
-		generatePythonRangeCheck(*schema.MinLength, "len(value)", "was shorter than allowable minimum", "%d", false, "<", "", buffer)
+	buffer.Print("\nreturn")
+	buffer.AddIndentation(-1)
+
+	generatePythonChecks(subschema, failure, buffer)
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_OBJECT:
+		buffer.Print("\nvalue.collect_violations(path, violations)")
+
+	case SCHEMATYPE_ARRAY:
+
+		items = subschema.(*ArraySchema).Items
+		if needsValidation(items, "py") {
+
+			buffer.Print("\nfor i, item in enumerate(value):")
+			buffer.AddIndentation(1)
+			buffer.Printf("\nself._collect_%s_item_violations(path + \"[\" + str(i) + \"]\", item, violations)", name)
+			buffer.AddIndentation(-1)
+		}
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\n")
+
+	if subschema.GetSchemaType() == SCHEMATYPE_ARRAY && needsValidation(items, "py") {
+		generatePythonValueValidation(items, failure, name+"_item", false, buffer)
 	}
+}
+
+/*
+	A checkFailure which adds a description of the violation (with the path in 'path', and the offending 'value') to 'violations'.
+*/
+func getPythonViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.append(path + \"%s\" + str(value))", getViolationText(keyword, description))
+}
+
+/*
+	A checkFailure like getPythonViolation, for writeOnly values, which leaves the value out of the description.
+*/
+func getPythonWriteOnlyViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.append(path + \"%s\")", getWriteOnlyViolationText(keyword, description))
+}
 
-	if schema.MaxLength != nil {
-		generatePythonRangeCheck(*schema.MaxLength, "len(value)", "was longer than allowable maximum", "%d", false, ">", "", buffer)
+/*
+	Generates a method which checks the given [condition] against an instance of [schema].
+	If [collect]ing, the method adds a violation to the given list for each field which doesn't meet the condition
+	(described with the given prefix before its name); otherwise it raises on the first.
+	Constraints on properties are checked by reusing the setter checks against a local "value".
+	Properties which aren't present are not checked.
+*/
+func generatePythonCondition(schema *ObjectSchema, condition *ConditionalSchema, name string, collect bool, options GeneratorOptions, buffer *BufferedFormatString) {
+
+	var subschema TypeSchema
+	var failure checkFailure
+
+	if collect {
+		buffer.Printf("\ndef _collect_%s_condition_violations(self, prefix, violations):", name)
+	} else {
+		buffer.Printf("\ndef _validate_%s(self):", name)
 	}
+	buffer.AddIndentation(1)
 
-	if schema.HasEnum() {
-		generatePythonEnumCheck(schema, buffer, schema.GetEnum(), "\"", "\"")
+	for _, propertyName := range condition.RequiredProperties {
+
+		buffer.Printf("\nif(getattr(self, \"%s\", None) == None):", getPythonFieldName(propertyName, options))
+		buffer.AddIndentation(1)
+
+		if collect {
+			buffer.Printf("\nviolations.append(prefix + \"%s: required: is required\")", sanitizeQuotedString(propertyName))
+		} else {
+			buffer.Printf("\nraise ValueError(\"Property '%s' is required\")", propertyName)
+		}
+
+		buffer.AddIndentation(-1)
 	}
 
-	if schema.Pattern != nil {
+	for _, propertyName := range condition.GetOrderedPropertyNames() {
+
+		subschema = condition.Properties[propertyName]
+		if !subschema.HasConstraints() {
+			continue
+		}
+
+		failure = failWithStatement
+
+		if collect {
 
-		buffer.Printf("\nif(not re.match(\"%s\", value)):", *schema.Pattern)
+			failure = chooseViolation(schema.Properties[propertyName], getPythonViolation, getPythonWriteOnlyViolation)
+			buffer.Printf("\npath = prefix + \"%s\"", sanitizeQuotedString(propertyName))
+		}
+
+		buffer.Printf("\nvalue = getattr(self, \"%s\", None)", getPythonFieldName(propertyName, options))
+		buffer.Print("\nif(value != None):")
 		buffer.AddIndentation(1)
 
-		buffer.Printf("\nraise ValueError(\"Value '\" + value + \"' did not match pattern '%s'\")", *schema.Pattern)
+		generatePythonChecks(subschema, failure, buffer)
 
 		buffer.AddIndentation(-1)
 	}
-}
 
-func generatePythonNumericSetter(schema NumericSchemaType, buffer *BufferedFormatString) {
+	buffer.Print("\nreturn")
+	buffer.AddIndentation(-1)
+	buffer.Print("\n")
+}
 
-	if schema.HasMinimum() {
-		generatePythonRangeCheck(schema.GetMinimum(), "value", "is under the allowable minimum", schema.GetConstraintFormat(), schema.IsExclusiveMinimum(), "<=", "<", buffer)
+/*
+	Generates all the checks appropriate for the type of the given [subschema],
+	each of which reports (with [fail]) if 'value' violates a constraint.
+*/
+func generatePythonChecks(subschema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING:
+		generatePythonStringSetter(subschema.(*StringSchema), fail, buffer)
+	case SCHEMATYPE_INTEGER:
+		fallthrough
+	case SCHEMATYPE_NUMBER:
+		generatePythonNumericSetter(subschema.(NumericSchemaType), fail, buffer)
+	case SCHEMATYPE_BOOLEAN:
+		generatePythonBooleanSetter(subschema.(*BooleanSchema), fail, buffer)
+	case SCHEMATYPE_ARRAY:
+		generatePythonArraySetter(subschema.(*ArraySchema), fail, buffer)
 	}
+}
 
-	if schema.HasMaximum() {
-		generatePythonRangeCheck(schema.GetMaximum(), "value", "is over the allowable maximum", schema.GetConstraintFormat(), schema.IsExclusiveMaximum(), ">=", ">", buffer)
+func generatePythonStringSetter(schema *StringSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum, pattern *ModelRule
+
+	generatePythonNotCheck(schema, fail, buffer)
+
+	generatePythonRangeChecks(schema, MODELSUBJECT_LENGTH, "len(value)", "%d", fail, buffer)
+
+	// byte lengths are only supported for binary content, whose length is already in bytes.
+	if schema.IsBinary() {
+		generatePythonRangeChecks(schema, MODELSUBJECT_BYTE_LENGTH, "len(value)", "%d", fail, buffer)
 	}
 
-	if schema.HasEnum() {
-		generatePythonEnumCheck(schema, buffer, schema.GetEnum(), "", "")
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generatePythonEnumCheck(buffer, enum, "\"", "\"", fail)
 	}
 
-	if schema.HasMultiple() {
+	pattern = getModelRule(schema, MODELRULE_PATTERN)
+	if pattern != nil {
 
-		buffer.Printf("\n\t\tif(value %% %f != 0):\n\t", schema.GetMultiple())
+		// json schema patterns aren't anchored, so they can match anywhere (re.match would only match at the start).
+		buffer.Printf("\nif(not re.search(\"%s\", value)):", pattern.Value)
 		buffer.AddIndentation(1)
 
-		buffer.Printf("\nraise ValueError.new(\"Property '\" + value + \"' was not a multiple of %v\")", schema.GetMultiple())
+		buffer.Printf("\n%s", fail(pattern.Keyword, fmt.Sprintf("did not match pattern '%s'", pattern.Value), fmt.Sprintf("raise ValueError(\"Value '\" + value + \"' did not match pattern '%s'\")", pattern.Value)))
 
 		buffer.AddIndentation(-1)
 	}
 }
 
-func generatePythonObjectSetter(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generatePythonNumericSetter(schema NumericSchemaType, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum, multiple *ModelRule
+	var format, prefix, postfix string
+
+	format = schema.GetConstraintFormat()
+
+	// decimals accept anything Decimal() does; floats are converted by their shortest representation, so 0.1 stays 0.1.
+	if isDecimal(schema.(TypeSchema)) {
+
+		buffer.Print("\nvalue = Decimal(str(value))")
+
+		prefix = "Decimal(\""
+		postfix = "\")"
+		format = prefix + format + postfix
+	}
+
+	generatePythonNotCheck(schema.(TypeSchema), fail, buffer)
+
+	generatePythonRangeChecks(schema.(TypeSchema), MODELSUBJECT_VALUE, "value", format, fail, buffer)
 
-	if !schema.Nullable {
-		generatePythonNullCheck(buffer)
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generatePythonEnumCheck(buffer, enum, prefix, postfix, fail)
+	}
+
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
+
+		buffer.Printf("\nif(value %% %s%v%s != 0):", prefix, multiple.Value, postfix)
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s\n", fail(multiple.Keyword, fmt.Sprintf("was not a multiple of %v", multiple.Value), fmt.Sprintf("raise ValueError(\"Property '\" + str(value) + \"' was not a multiple of %v\")", multiple.Value)))
+
+		buffer.AddIndentation(-1)
 	}
 }
 
-func generatePythonArraySetter(schema *ArraySchema, buffer *BufferedFormatString) {
+func generatePythonBooleanSetter(schema *BooleanSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum *ModelRule
+	var value interface{}
+
+	generatePythonNotCheck(schema, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		value = enum.Value.([]interface{})[0]
 
-	if !schema.Nullable {
-		generatePythonNullCheck(buffer)
+		buffer.Printf("\nif(value != %s):", getConstLiteral(value, "True", "False"))
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s\n", fail(enum.Keyword, fmt.Sprintf("must be %s", getConstLiteral(value, "True", "False")), fmt.Sprintf("raise ValueError(\"Property must be %s.\")", getConstLiteral(value, "True", "False"))))
+		buffer.AddIndentation(-1)
 	}
+}
 
-	if schema.MinItems != nil {
-		generatePythonRangeCheck(*schema.MinItems, "len(value)", "does not have enough items", "%d", false, "<", "", buffer)
+/*
+	Generates code which raises if 'value' matches the "not" subschema of the given [schema].
+	The subschema's checks are run inside a try block, and the value is only valid if one of them raises.
+*/
+func generatePythonNotCheck(schema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var not *ModelRule
+
+	not = getModelRule(schema, MODELRULE_NOT)
+	if not == nil {
+		return
 	}
 
-	if schema.MaxItems != nil {
-		generatePythonRangeCheck(*schema.MaxItems, "len(value)", "does not have enough items", "%d", false, ">", "", buffer)
+	buffer.Print("\ntry:")
+	buffer.AddIndentation(1)
+
+	// the subschema may not have any checks at all.
+	buffer.Print("\npass")
+	generatePythonChecks(not.Value.(TypeSchema), failWithStatement, buffer)
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\nexcept Exception:")
+	buffer.AddIndentation(1)
+	buffer.Print("\npass")
+	buffer.AddIndentation(-1)
+	buffer.Print("\nelse:")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s\n", fail(not.Keyword, not.Message, "raise ValueError(\"Property matched a schema which it must not match.\")"))
+	buffer.AddIndentation(-1)
+}
+
+func generatePythonArraySetter(schema *ArraySchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	generatePythonRangeChecks(schema, MODELSUBJECT_ITEMS, "len(value)", "%d", fail, buffer)
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_UNIQUE, MODELSUBJECT_ITEMS) {
+		generatePythonUniqueCheck(rule, fail, buffer)
 	}
 }
 
-func generatePythonRangeCheck(value interface{}, reference, message, format string, exclusive bool, comparator, exclusiveComparator string, buffer *BufferedFormatString) {
+/*
+	Generates a check which reports (with [fail]) if any two items of 'value' are equal, violating the given unique [rule].
+	Items are compared pairwise, since lists aren't hashable.
+*/
+func generatePythonUniqueCheck(rule *ModelRule, fail checkFailure, buffer *BufferedFormatString) {
+
+	buffer.Print("\nif(any(value[i] == value[j] for i in range(len(value)) for j in range(i + 1, len(value)))):")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s\n", fail(rule.Keyword, rule.Message, fmt.Sprintf("raise ValueError(\"Property '\" + str(value) + \"' %s.\")", rule.Message)))
+	buffer.AddIndentation(-1)
+}
 
-	var compareString string
+/*
+	Generates a check for each range rule of the given [schema] on the given [subject], which is referred to in code as [reference].
+	Bounds are written with the given [format].
+*/
+func generatePythonRangeChecks(schema TypeSchema, subject ModelSubject, reference string, format string, fail checkFailure, buffer *BufferedFormatString) {
 
-	if exclusive {
-		compareString = exclusiveComparator
-	} else {
-		compareString = comparator
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, subject) {
+		generatePythonRangeCheck(rule, reference, fmt.Sprintf(format, rule.Value), fail, buffer)
 	}
+}
 
-	buffer.Printf("\nif(%s %s "+format+"):", reference, compareString, value)
+/*
+	Generates a check which reports (with [fail]) if [reference] violates the given range [rule], with the bound written as [value].
+*/
+func generatePythonRangeCheck(rule *ModelRule, reference string, value string, fail checkFailure, buffer *BufferedFormatString) {
+
+	buffer.Printf("\nif(%s %s %s):", reference, rule.Violation, value)
 	buffer.AddIndentation(1)
 
-	buffer.Printf("\nraise ValueError(\"Property '\"+ value +\"' %s.\")\n", message)
+	buffer.Printf("\n%s\n", fail(rule.Keyword, fmt.Sprintf("%s (%v)", rule.Message, rule.Value), fmt.Sprintf("raise ValueError(\"Property '\" + str(value) + \"' %s.\")", rule.Message)))
 
 	buffer.AddIndentation(-1)
 }
 
 /*
-	Generates code which throws an error if the given [parameter]'s value is not contained in the given [validValues].
+	Generates code which reports (with [fail]) if 'value' is not one of the values of the given enum [rule].
 */
-func generatePythonEnumCheck(schema TypeSchema, buffer *BufferedFormatString, enumValues []interface{}, prefix string, postfix string) {
+func generatePythonEnumCheck(buffer *BufferedFormatString, rule *ModelRule, prefix string, postfix string, fail checkFailure) {
 
+	var enumValues []interface{}
 	var stringValues []string
 	var length int
 
+	enumValues = rule.Value.([]interface{})
 	length = len(enumValues)
 
 	if length <= 0 {
@@ -338,7 +871,7 @@ func generatePythonEnumCheck(schema TypeSchema, buffer *BufferedFormatString, en
 	buffer.Print("\nif(value not in validValues):")
 	buffer.AddIndentation(1)
 
-	buffer.Print("\nraise ValueError(\"Given value '\"+value+\"' was not found in list of acceptable values\")\n")
+	buffer.Printf("\n%s\n", fail(rule.Keyword, "was not found in list of acceptable values", "raise ValueError(\"Given value '\" + str(value) + \"' was not found in list of acceptable values\")"))
 
 	buffer.AddIndentation(-1)
 }
@@ -350,3 +883,27 @@ func generatePythonNullCheck(buffer *BufferedFormatString) {
 	buffer.Print("\nraise ValueError(\"Cannot set property to null value\")")
 	buffer.AddIndentation(-1)
 }
+
+/*
+	Generates a DeprecationWarning for the given [name] if the given schema is deprecated.
+*/
+func generatePythonDeprecation(schema TypeSchema, name string, buffer *BufferedFormatString) {
+
+	if !schema.IsDeprecated() {
+		return
+	}
+
+	buffer.Printf("\nwarnings.warn(\"%s is deprecated\", DeprecationWarning, stacklevel=2)", name)
+}
+
+/*
+	Returns the name of the field which holds the given property.
+	Python can't enforce privacy, so private fields (when every field has accessors) are named with a leading underscore, by convention.
+*/
+func getPythonFieldName(propertyName string, options GeneratorOptions) string {
+
+	if options.AllAccessors {
+		return "_" + ToSnakeCase(propertyName)
+	}
+	return ToSnakeCase(propertyName)
+}
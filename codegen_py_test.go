@@ -0,0 +1,172 @@
+package presilo
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+	Deserializes a dict holding nested objects with the Python generated for the conformance "objects" schema,
+	then validates it; nested objects have to be deserialized into their own classes for validate to check them.
+*/
+const pythonDeserializeThenValidate = `
+from conformance import Objects
+
+objects = Objects.deserialize_from({
+	"id": 1,
+	"password": "correct horse",
+	"recoveryCodes": ["123456"],
+	"legacy": None,
+	"owner": {"name": "Alice", "email": "alice@example.com"},
+	"home": None,
+	"work": {"street": "Main", "city": "Springfield"},
+})
+objects.validate()
+
+objects.work.city = ""
+try:
+	objects.validate()
+	print("no violations")
+except ValueError as e:
+	print(e)
+`
+
+/*
+	Breaks the conditional constraints of the conformance "conditionals" schema; each is reported against its own field.
+*/
+const pythonConditionalViolations = `
+from conformance import Conditionals
+
+conditionals = Conditionals()
+conditionals.country = "US"
+conditionals.postal_code = "abc"
+conditionals.card = "visa"
+
+try:
+	conditionals.validate()
+	print("no violations")
+except ValueError as e:
+	print(e)
+`
+
+/*
+	Breaks the uniqueItems constraints of the conformance "arrays" schema, with a duplicated string and a duplicated (nested) list.
+*/
+const pythonDuplicateItems = `
+from conformance import Arrays
+
+arrays = Arrays()
+try:
+	arrays.set_tags(["a", "b", "a"])
+except ValueError as e:
+	print(e)
+
+arrays.set_matrix([[1, 2], [1, 3]])
+arrays.tags = ["a", "a"]
+arrays.matrix = [[1, 2], [1, 2]]
+
+try:
+	arrays.validate()
+	print("no violations")
+except ValueError as e:
+	print(e)
+`
+
+func TestPythonDeserializeThenValidate(test *testing.T) {
+
+	var output string
+
+	output = runPythonConformance(test, "objects", pythonDeserializeThenValidate)
+	if output != "work.city: minLength: was shorter than allowable minimum (1), value: " {
+		test.Errorf("Expected the nested object's violation, but got:\n%s", output)
+	}
+}
+
+func TestPythonDuplicateItems(test *testing.T) {
+
+	var output, expected string
+
+	expected = strings.Join([]string{
+		"Property '['a', 'b', 'a']' has duplicate items.",
+		"tags: uniqueItems: has duplicate items, value: ['a', 'a']",
+		"matrix: uniqueItems: has duplicate items, value: [[1, 2], [1, 2]]",
+	}, "\n")
+
+	output = runPythonConformance(test, "arrays", pythonDuplicateItems)
+	if output != expected {
+		test.Errorf("Expected each array with duplicate items to be rejected, but got:\n%s", output)
+	}
+}
+
+func TestPythonConditionalViolations(test *testing.T) {
+
+	var output, expected string
+
+	expected = strings.Join([]string{
+		"state: required: is required",
+		"postalCode: pattern: did not match pattern '^[0-9]{5}$', value: abc",
+		"billingAddress: dependentRequired: is required when 'card' is present",
+	}, "\n")
+
+	output = runPythonConformance(test, "conditionals", pythonConditionalViolations)
+	if output != expected {
+		test.Errorf("Expected a violation for each field of each condition, but got:\n%s", output)
+	}
+}
+
+/*
+	Generates the Python for the given conformance [schemaName] into a "conformance" package,
+	runs the given [script] beside it, and returns what it prints (without the final newline).
+	Skips the test if there's no python3 to run it with.
+*/
+func runPythonConformance(test *testing.T, schemaName string, script string) string {
+
+	var context *SchemaParseContext
+	var files map[string]string
+	var command *exec.Cmd
+	var directory, path string
+	var output []byte
+	var err error
+
+	_, err = exec.LookPath("python3")
+	if err != nil {
+		test.Skip("python3 isn't installed")
+	}
+
+	context, err = parseConformanceSchema(filepath.Join(conformanceDirectory, "schemas", schemaName+".json"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	files, err = GenerateCode(context, "conformance", "py", "\t", false, true, GeneratorOptions{})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	directory = test.TempDir()
+
+	err = writeGoldenFiles(filepath.Join(directory, "conformance"), files)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	path = filepath.Join(directory, "check.py")
+
+	err = os.WriteFile(path, []byte(script), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	command = exec.Command("python3", "-B", path)
+	command.Dir = directory
+
+	output, err = command.CombinedOutput()
+	if err != nil {
+		test.Fatalf("Running the generated code failed: %s\n%s", err.Error(), output)
+	}
+
+	return strings.TrimSuffix(string(output), "\n")
+}
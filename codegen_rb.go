@@ -2,27 +2,49 @@ package presilo
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 )
 
-func GenerateRuby(schema *ObjectSchema, module string, tabstyle string) string {
+func GenerateRuby(schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) string {
 
 	var buffer *BufferedFormatString
+	var model *ModelType
+
+	warnUncheckedNots(schema, "rb", "Ruby")
 
 	buffer = NewBufferedFormatString(tabstyle)
+	model = NewModelType(schema)
+
+	if containsDecimal(schema) {
+		buffer.Print("require 'bigdecimal'\n")
+	}
+
+	if containsBinary(schema) {
+		buffer.Print("require 'base64'\n")
+	}
+
+	if containsDecimal(schema) || containsBinary(schema) {
+		buffer.Print("\n")
+	}
+
 	buffer.Printf("module %s\n", ToCamelCase(module))
 	buffer.AddIndentation(1)
 
-	generateRubySignature(schema, buffer)
+	generateRubySignature(schema, options, buffer)
 	buffer.Print("\n")
-	generateRubyConstructor(schema, buffer)
+	generateRubyConstructor(model, buffer)
 	buffer.Print("\n")
-	generateRubySerializer(schema, buffer)
+	generateRubySerializer(model, buffer)
 	buffer.Print("\n")
-	generateRubyDeserializer(schema, buffer)
+	generateRubyDeserializer(model, buffer)
 	buffer.Print("\n")
-	generateRubyFunctions(schema, buffer)
+	generateRubyFunctions(model, buffer)
+	generateRubyConditions(schema, buffer)
+	generateRubyValidation(model, buffer)
+	buffer.Print("\n")
+	generateProtectedRegion(ToCamelCase(schema.GetTitle()), "#", buffer)
 
 	buffer.AddIndentation(-1)
 	buffer.Print("\nend")
@@ -39,13 +61,76 @@ func ValidateRubyModule(module string) bool {
 	return err == nil && matched
 }
 
-func generateRubySignature(schema *ObjectSchema, buffer *BufferedFormatString) {
+/*
+	Returns the path of the file the given [schema] is written to; in a snake_case directory for each module it's nested in,
+	named after its class in snake_case, as Ruby's autoloaders expect.
+*/
+func GetRubyFileName(schema *ObjectSchema, module string) string {
+	return path.Join(getRubyModuleDirectory(module), ToSnakeCase(schema.GetTitle()))
+}
+
+/*
+	Returns the directory which the types of the given [module] are written to, with a snake_case directory for each module it's nested in.
+*/
+func getRubyModuleDirectory(module string) string {
+
+	var ret []string
+
+	for _, name := range strings.Split(module, "::") {
+		ret = append(ret, ToSnakeCase(name))
+	}
+
+	return path.Join(ret...)
+}
+
+/*
+	Generates a require of the file of each of the given [dependencies], which are always in the same directory as [schema].
+*/
+func GenerateRubyImports(schema *ObjectSchema, dependencies []*ObjectSchema, module string, tabstyle string) string {
+
+	var buffer *BufferedFormatString
+
+	buffer = NewBufferedFormatString(tabstyle)
+
+	for _, dependency := range dependencies {
+		buffer.Printf("require_relative '%s'\n", path.Base(GetRubyFileName(dependency, module)))
+	}
+
+	buffer.Print("\n")
+	return buffer.String()
+}
+
+/*
+	Generates a loader named after the module, next to the directory of its files, which requires every one of the given [schemas].
+	For the module "Acme::Models", requiring "acme/models" loads every type.
+*/
+func GenerateRubyIndex(schemas []*ObjectSchema, module string, tabstyle string) (string, string) {
+
+	var buffer *BufferedFormatString
+	var directory string
+
+	buffer = NewBufferedFormatString(tabstyle)
+	directory = getRubyModuleDirectory(module)
+
+	// relative to the loader, which is beside the directory.
+	for _, schema := range schemas {
+		buffer.Printf("require_relative '%s'\n", path.Join(path.Base(directory), ToSnakeCase(schema.GetTitle())))
+	}
+
+	return directory, buffer.String()
+}
+
+func generateRubySignature(schema *ObjectSchema, options GeneratorOptions, buffer *BufferedFormatString) {
 
 	var subschema TypeSchema
 	var readers, accessors []string
 	var propertyName string
 	var toWrite string
 
+	if schema.IsDeprecated() {
+		buffer.Print("\n# @deprecated")
+	}
+
 	buffer.Printf("\nclass %s", ToCamelCase(schema.Title))
 	buffer.AddIndentation(1)
 
@@ -54,7 +139,8 @@ func generateRubySignature(schema *ObjectSchema, buffer *BufferedFormatString) {
 		subschema = schema.Properties[propertyName]
 		propertyName = ToSnakeCase(propertyName)
 
-		if subschema.HasConstraints() {
+		// when every field has accessors, none can be written without its setter.
+		if subschema.HasConstraints() || subschema.IsReadOnly() || options.AllAccessors {
 			toWrite = fmt.Sprintf(":%s", propertyName)
 			readers = append(readers, toWrite)
 
@@ -82,35 +168,64 @@ func generateRubySignature(schema *ObjectSchema, buffer *BufferedFormatString) {
 	}
 }
 
-func generateRubyConstructor(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateRubyConstructor(model *ModelType, buffer *BufferedFormatString) {
 
 	var declarations []string
-	var propertyName string
 
 	buffer.Print("\ndef initialize(")
 
-	for _, propertyName = range schema.RequiredProperties {
-
-		propertyName = ToSnakeCase(propertyName)
-		declarations = append(declarations, propertyName)
+	for _, field := range model.ConstructorFields {
+		declarations = append(declarations, ToSnakeCase(field.Name))
 	}
 
 	buffer.Printf("%s)\n", strings.Join(declarations, ","))
 	buffer.AddIndentation(1)
 
-	for _, propertyName = range schema.RequiredProperties {
-		buffer.Printf("\nset_%s(%s)", propertyName, propertyName)
+	// const fields are fixed, and have no setter.
+	for _, field := range model.GetConstFields() {
+
+		if isDecimal(field.Schema) {
+			buffer.Printf("\n@%s = BigDecimal(\"%s\")", ToSnakeCase(field.Name), field.Const)
+			continue
+		}
+		buffer.Printf("\n@%s = %s", ToSnakeCase(field.Name), getConstLiteral(field.Const, "true", "false"))
+	}
+
+	for _, parameterName := range declarations {
+		buffer.Printf("\nset_%s(%s)", parameterName, parameterName)
 	}
 
 	buffer.AddIndentation(-1)
 	buffer.Print("\nend\n")
 }
 
-func generateRubySerializer(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateRubySerializer(model *ModelType, buffer *BufferedFormatString) {
+
+	var schema *ObjectSchema
+	var writeOnly, binary, binaryArrays []string
+	var subschema TypeSchema
+	var propertyName, title string
+
+	schema = model.Schema
+	title = ToCamelCase(model.Name)
+
+	for _, field := range model.GetWriteOnlyFields() {
+		writeOnly = append(writeOnly, fmt.Sprintf("\"%s\"", ToSnakeCase(field.Name)))
+	}
+
+	// binary content is indistinguishable from text at runtime, so it's found by name.
+	for _, field := range model.Fields {
 
-	var title string
+		subschema = field.Schema
+		propertyName = field.Name
 
-	title = ToCamelCase(schema.GetTitle())
+		if isRubyBinary(subschema) {
+			binary = append(binary, fmt.Sprintf("\"%s\"", ToSnakeCase(propertyName)))
+		}
+		if subschema.GetSchemaType() == SCHEMATYPE_ARRAY && isRubyBinary(subschema.(*ArraySchema).Items) {
+			binaryArrays = append(binaryArrays, fmt.Sprintf("\"%s\"", ToSnakeCase(propertyName)))
+		}
+	}
 
 	// serialize
 	buffer.Printf("\n# Serializes and returns a hash of this %s.", title)
@@ -121,7 +236,33 @@ func generateRubySerializer(schema *ObjectSchema, buffer *BufferedFormatString)
 	buffer.Print("\ninstance_variables.each {|field|")
 	buffer.AddIndentation(1)
 	buffer.Print("\nfield_name = field.to_s().delete(\"@\")")
+
+	// writeOnly fields are never serialized.
+	if len(writeOnly) > 0 {
+		buffer.Printf("\nnext if [%s].include?(field_name)", strings.Join(writeOnly, ", "))
+	}
+
 	buffer.Print("\nfield_value = instance_variable_get(field)")
+	if len(binary) > 0 {
+
+		buffer.Printf("\n\nif [%s].include?(field_name)", strings.Join(binary, ", "))
+		buffer.AddIndentation(1)
+		buffer.Print("\nret[field_name] = field_value.nil? ? nil : Base64.strict_encode64(field_value)")
+		buffer.Print("\nnext")
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend")
+	}
+
+	if len(binaryArrays) > 0 {
+
+		buffer.Printf("\n\nif [%s].include?(field_name)", strings.Join(binaryArrays, ", "))
+		buffer.AddIndentation(1)
+		buffer.Print("\nret[field_name] = field_value.nil? ? nil : field_value.map {|item| Base64.strict_encode64(item) }")
+		buffer.Print("\nnext")
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend")
+	}
+
 	buffer.Print("\n\nif field_value.methods.include? 'to_hash'")
 	buffer.AddIndentation(1)
 	buffer.Print("\nret[field_name] = field_value.to_hash()")
@@ -129,6 +270,24 @@ func generateRubySerializer(schema *ObjectSchema, buffer *BufferedFormatString)
 	buffer.AddIndentation(-1)
 	buffer.Print("\nend")
 
+	// BigDecimals would otherwise be written in scientific notation, or rounded to floats.
+	if containsDecimal(schema) {
+
+		buffer.Print("\n\nif field_value.is_a? BigDecimal")
+		buffer.AddIndentation(1)
+		buffer.Print("\nret[field_name] = field_value.to_s(\"F\")")
+		buffer.Print("\nnext")
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend")
+
+		buffer.Print("\n\nif field_value.is_a? Array")
+		buffer.AddIndentation(1)
+		buffer.Print("\nret[field_name] = field_value.map {|item| item.is_a?(BigDecimal) ? item.to_s(\"F\") : item }")
+		buffer.Print("\nnext")
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend\n")
+	}
+
 	buffer.Print("\nret[field_name] = field_value")
 
 	buffer.AddIndentation(-1)
@@ -138,15 +297,15 @@ func generateRubySerializer(schema *ObjectSchema, buffer *BufferedFormatString)
 	buffer.Print("\nend")
 }
 
-func generateRubyDeserializer(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateRubyDeserializer(model *ModelType, buffer *BufferedFormatString) {
 
 	var property TypeSchema
 	var ctorArguments []string
-	var argument string
+	var argument, value string
 	var className string
-	var propertyName, casedPropertyName string
+	var propertyName string
 
-	className = ToCamelCase(schema.GetTitle())
+	className = ToCamelCase(model.Name)
 
 	buffer.Printf("\ndef self.from_hash(map)")
 	buffer.AddIndentation(1)
@@ -154,36 +313,44 @@ func generateRubyDeserializer(schema *ObjectSchema, buffer *BufferedFormatString
 	// use constructor
 	buffer.Printf("\nret = %s.new(", className)
 
-	for _, propertyName = range schema.RequiredProperties {
+	for _, field := range model.ConstructorFields {
 
-		argument = fmt.Sprintf("map[\"%s\"]", ToJavaCase(propertyName))
-		ctorArguments = append(ctorArguments, argument)
+		argument = fmt.Sprintf("map[\"%s\"]", sanitizeQuotedString(field.Name))
+		ctorArguments = append(ctorArguments, getRubyDeserializedValue(field.Schema, argument))
 	}
 
 	buffer.Printf("%s)", strings.Join(ctorArguments, ", "))
 
 	// misc setters
 	buffer.Printf("\n")
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
+	for _, field := range model.Fields {
+
+		property = field.Schema
+		propertyName = field.Name
+
+		// if it's already set (or can only have one value), skip it.
+		if model.IsConstructorField(field) || field.IsConst {
+			continue
+		}
 
-		property = schema.Properties[propertyName]
-		propertyName = ToJavaCase(property.GetTitle())
-		casedPropertyName = fmt.Sprintf("map[\"%s\"]", propertyName)
+		value = getRubyDeserializedValue(property, fmt.Sprintf("map[\"%s\"]", sanitizeQuotedString(propertyName)))
 
-		// if it's already set, skip it.
-		if arrayContainsString(ctorArguments, casedPropertyName) {
+		// readOnly fields have neither setter nor writer.
+		if !field.Settable {
+
+			buffer.Printf("\nret.instance_variable_set(:@%s, %s)", ToSnakeCase(propertyName), value)
 			continue
 		}
 
-		// if it's constrained, use the setter
-		if property.HasConstraints() {
+		// if it's constrained (or a decimal, which the setter converts), use the setter
+		if property.HasConstraints() || isDecimal(property) {
 
-			buffer.Printf("\nret.set_%s(%s)", ToJavaCase(propertyName), casedPropertyName)
+			buffer.Printf("\nret.set_%s(%s)", ToSnakeCase(propertyName), value)
 			continue
 		}
 
 		// otherwise set.
-		buffer.Printf("\nret.%s = %s", propertyName, casedPropertyName)
+		buffer.Printf("\nret.%s = %s", ToSnakeCase(propertyName), value)
 	}
 
 	buffer.Printf("\nreturn ret")
@@ -191,15 +358,55 @@ func generateRubyDeserializer(schema *ObjectSchema, buffer *BufferedFormatString
 	buffer.Printf("\nend\n")
 }
 
-func generateRubyFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
+/*
+	Returns an expression which converts the given deserialized [value] to the type used for the given [schema].
+	Binary content arrives as base64, decimal items as floats (single decimals are converted by their setters),
+	and objects as hashes, which are deserialized into their own class.
+*/
+func getRubyDeserializedValue(schema TypeSchema, value string) string {
+
+	var conversion string
+
+	if isRubyBinary(schema) {
+		return fmt.Sprintf("(%s.nil? ? nil : Base64.strict_decode64(%s))", value, value)
+	}
+
+	if schema.GetSchemaType() == SCHEMATYPE_OBJECT {
+		return fmt.Sprintf("(%s.nil? ? nil : %s.from_hash(%s))", value, ToCamelCase(schema.GetTitle()), value)
+	}
+
+	if schema.GetSchemaType() != SCHEMATYPE_ARRAY {
+		return value
+	}
+
+	schema = schema.(*ArraySchema).Items
+
+	if isDecimal(schema) {
+		conversion = "BigDecimal(item.to_s)"
+	} else if isRubyBinary(schema) {
+		conversion = "Base64.strict_decode64(item)"
+	} else if schema.GetSchemaType() == SCHEMATYPE_OBJECT {
+		conversion = fmt.Sprintf("(item.nil? ? nil : %s.from_hash(item))", ToCamelCase(schema.GetTitle()))
+	} else {
+		return value
+	}
+
+	return fmt.Sprintf("(%s.nil? ? nil : %s.map {|item| %s })", value, value, conversion)
+}
+
+func isRubyBinary(schema TypeSchema) bool {
+	return schema.GetSchemaType() == SCHEMATYPE_STRING && schema.(*StringSchema).IsBinary()
+}
+
+func generateRubyFunctions(model *ModelType, buffer *BufferedFormatString) {
 
 	var subschema TypeSchema
-	var propertyName, snakeName, description string
+	var snakeName, description string
 
-	for _, propertyName = range schema.GetOrderedPropertyNames() {
+	for _, field := range model.Fields {
 
-		subschema = schema.Properties[propertyName]
-		snakeName = ToSnakeCase(propertyName)
+		subschema = field.Schema
+		snakeName = ToSnakeCase(field.Name)
 		description = subschema.GetDescription()
 		description = strings.Replace(description, "\n", "\n# ", -1)
 
@@ -208,6 +415,7 @@ func generateRubyFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
 			buffer.Printf("\n# Gets the value of %s, which is defined as:\n# %s", snakeName, description)
 		}
 
+		generateRubyDeprecation(subschema, buffer)
 		buffer.Printf("\ndef get_%s()", snakeName)
 		buffer.AddIndentation(1)
 
@@ -216,138 +424,501 @@ func generateRubyFunctions(schema *ObjectSchema, buffer *BufferedFormatString) {
 		buffer.AddIndentation(-1)
 		buffer.Print("\nend\n")
 
+		// readOnly and const fields are never set by consumers, no setter.
+		if !field.Settable {
+			continue
+		}
+
 		// setter
 		if len(description) > 0 {
 			buffer.Printf("\n# Sets the value of %s, which is defined as:\n# %s", snakeName, description)
 		}
 
+		generateRubyDeprecation(subschema, buffer)
+
 		buffer.Printf("\ndef set_%s(value)", snakeName)
 		buffer.AddIndentation(1)
 
-		switch subschema.GetSchemaType() {
-		case SCHEMATYPE_STRING:
-			generateRubyStringSetter(subschema.(*StringSchema), buffer)
-		case SCHEMATYPE_INTEGER:
-			fallthrough
-		case SCHEMATYPE_NUMBER:
-			generateRubyNumericSetter(subschema.(NumericSchemaType), buffer)
-		case SCHEMATYPE_OBJECT:
-			generateRubyObjectSetter(subschema.(*ObjectSchema), buffer)
-		case SCHEMATYPE_ARRAY:
-			generateRubyArraySetter(subschema.(*ArraySchema), buffer)
+		// only a setter can be given null to check; everything else checks values once it knows they're there.
+		if isNullRefused(subschema) {
+			generateRubyNullCheck(buffer)
 		}
 
+		generateRubyChecks(subschema, failWithStatement, buffer)
+
 		buffer.Printf("\n@%s = value", snakeName)
 		buffer.AddIndentation(-1)
 		buffer.Print("\nend\n")
 	}
 }
 
-func generateRubyStringSetter(schema *StringSchema, buffer *BufferedFormatString) {
+/*
+	Generates a "collect_conditional_violations" method for the given schema, which checks constraints that span multiple properties;
+	if/then/else, dependentRequired, and dependentSchemas. collect_violations() adds whatever it finds.
+	The "if" schema is generated as a method which raises on the first violation found, since it only decides
+	which of "then" or "else" applies. Each other conditional schema is generated as its own method,
+	which adds a violation for each field (beneath the given prefix) which doesn't meet it.
+*/
+func generateRubyConditions(schema *ObjectSchema, buffer *BufferedFormatString) {
 
-	if !schema.Nullable {
-		generateRubyNullCheck(buffer)
+	if !schema.HasConditions() {
+		return
 	}
 
-	if schema.MinLength != nil {
-		generateRubyRangeCheck(*schema.MinLength, "value.length", "was shorter than allowable minimum", "%d", false, "<", "", buffer)
-	}
+	buffer.Printf("\n# Adds a description of each constraint of this %s which spans more than one field, and which it violates, to [violations].", ToCamelCase(schema.Title))
+	buffer.Print("\ndef collect_conditional_violations(prefix, violations)")
+	buffer.AddIndentation(1)
 
-	if schema.MaxLength != nil {
-		generateRubyRangeCheck(*schema.MaxLength, "value.length", "was longer than allowable maximum", "%d", false, ">", "", buffer)
-	}
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		buffer.Print("\nbegin")
+		buffer.AddIndentation(1)
+		buffer.Print("\nvalidate_if()\nmatched = true")
+		buffer.AddIndentation(-1)
+		buffer.Print("\nrescue StandardError")
+		buffer.AddIndentation(1)
+		buffer.Print("\nmatched = false")
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend\n")
+
+		if schema.Then != nil {
+
+			buffer.Print("\nif(matched)")
+			buffer.AddIndentation(1)
+			buffer.Print("\ncollect_then_condition_violations(prefix, violations)")
+			buffer.AddIndentation(-1)
 
-	if schema.HasEnum() {
-		generateRubyEnumCheck(schema, buffer, schema.GetEnum(), "'", "'")
+			if schema.Else != nil {
+				buffer.Print("\nelse")
+				buffer.AddIndentation(1)
+				buffer.Print("\ncollect_else_condition_violations(prefix, violations)")
+				buffer.AddIndentation(-1)
+			}
+		} else {
+			buffer.Print("\nunless(matched)")
+			buffer.AddIndentation(1)
+			buffer.Print("\ncollect_else_condition_violations(prefix, violations)")
+			buffer.AddIndentation(-1)
+		}
+		buffer.Print("\nend\n")
 	}
 
-	if schema.Pattern != nil {
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
 
-		buffer.Printf("\nif(value =~ /%s/)\n", *schema.Pattern)
+		buffer.Printf("\nunless(@%s == nil)", ToSnakeCase(propertyName))
 		buffer.AddIndentation(1)
 
-		buffer.Printf("\nraise StandardError.new(\"Value '#{value}' did not match pattern '%s'\")", *schema.Pattern)
+		for _, dependency := range schema.DependentRequired[propertyName] {
+
+			buffer.Printf("\nif(@%s == nil)", ToSnakeCase(dependency))
+			buffer.AddIndentation(1)
+			buffer.Printf("\nviolations.push(prefix + \"%s\")", strings.Replace(getDependentRequiredViolationText(dependency, propertyName), "#", "\\#", -1))
+			buffer.AddIndentation(-1)
+			buffer.Print("\nend")
+		}
+
+		if _, found := schema.DependentSchemas[propertyName]; found {
+			buffer.Printf("\ncollect_dependent_%s_condition_violations(prefix, violations)", ToSnakeCase(propertyName))
+		}
 
 		buffer.AddIndentation(-1)
-		buffer.Print("\nend")
+		buffer.Print("\nend\n")
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\nend\n")
+
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+
+		generateRubyCondition(schema, schema.If, "if", false, buffer)
+
+		if schema.Then != nil {
+			generateRubyCondition(schema, schema.Then, "then", true, buffer)
+		}
+		if schema.Else != nil {
+			generateRubyCondition(schema, schema.Else, "else", true, buffer)
+		}
+	}
+
+	for _, propertyName := range schema.GetOrderedDependencyNames() {
+
+		if condition, found := schema.DependentSchemas[propertyName]; found {
+			generateRubyCondition(schema, condition, "dependent_"+ToSnakeCase(propertyName), true, buffer)
+		}
+	}
+}
+
+/*
+	Generates a "validate" method for the given schema, which checks every constraint of every field (and of every object they hold),
+	and raises a StandardError which lists every violation, rather than stopping at the first.
+	The work is done by "collect_violations", which objects holding this one call with their own path.
+*/
+func generateRubyValidation(model *ModelType, buffer *BufferedFormatString) {
+
+	var schema *ObjectSchema
+	var fields []*ModelField
+
+	schema = model.Schema
+	fields = getValidatedFields(model, "rb")
+
+	buffer.Printf("\n# Checks every constraint of this %s, and of every object it holds,", ToCamelCase(schema.Title))
+	buffer.Print("\n# and raises a StandardError which lists every violation.")
+	buffer.Print("\ndef validate()")
+	buffer.AddIndentation(1)
+	buffer.Print("\nviolations = collect_violations(\"\", [])")
+	buffer.Print("\nunless(violations.empty?)")
+	buffer.AddIndentation(1)
+	buffer.Print("\nraise StandardError.new(violations.join(\"\\n\"))")
+	buffer.AddIndentation(-1)
+	buffer.Print("\nend")
+	buffer.AddIndentation(-1)
+	buffer.Print("\nend\n")
+
+	buffer.Printf("\n# Adds a description of every constraint this %s violates to [violations], and returns them.", ToCamelCase(schema.Title))
+	buffer.Print("\n# Each is described with the path of the field which violated it, beneath the given [path] of this object.")
+	buffer.Print("\ndef collect_violations(path, violations)")
+	buffer.AddIndentation(1)
+
+	if len(fields) > 0 || schema.HasConditions() {
+		buffer.Print("\nprefix = path.empty? ? \"\" : path + \".\"\n")
+	}
+
+	for _, field := range fields {
+		buffer.Printf("\ncollect_%s_violations(prefix + \"%s\", @%s, violations)", ToSnakeCase(field.Name), sanitizeQuotedString(field.Name), ToSnakeCase(field.Name))
+	}
+
+	if len(fields) > 0 {
+		buffer.Print("\n")
+	}
+
+	if schema.HasConditions() {
+		buffer.Print("\ncollect_conditional_violations(prefix, violations)\n")
+	}
+
+	buffer.Print("\nreturn violations")
+	buffer.AddIndentation(-1)
+	buffer.Print("\nend\n")
+
+	for _, field := range fields {
+		generateRubyValueValidation(field.Schema, chooseViolation(field.Schema, getRubyViolation, getRubyWriteOnlyViolation), ToSnakeCase(field.Name), field.Required, buffer)
 	}
 }
 
-func generateRubyNumericSetter(schema NumericSchemaType, buffer *BufferedFormatString) {
+/*
+	Generates a method which checks a value of the given [subschema], and anything it holds,
+	named after the given (snake-cased) [name]. Each field (and the items of each array) has its own method,
+	so that checking the items of an array doesn't clobber the array's own 'value' and 'path'.
+	A value which is missing is only a violation if it's [required].
+*/
+func generateRubyValueValidation(subschema TypeSchema, failure checkFailure, name string, required bool, buffer *BufferedFormatString) {
+
+	var items TypeSchema
+
+	buffer.Printf("\ndef collect_%s_violations(path, value, violations)", name)
+	buffer.AddIndentation(1)
+
+	buffer.Print("\nif(value == nil)")
+	buffer.AddIndentation(1)
+
+	if required {
+		buffer.Print("\nviolations.push(path + \": required: is required\")")
+	}
+
+	buffer.Print("\nreturn")
+	buffer.AddIndentation(-1)
+	buffer.Print("\nend\n")
+
+	generateRubyChecks(subschema, failure, buffer)
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_OBJECT:
+		buffer.Print("\nvalue.collect_violations(path, violations)")
 
-	if schema.HasMinimum() {
-		generateRubyRangeCheck(schema.GetMinimum(), "value", "is under the allowable minimum", schema.GetConstraintFormat(), schema.IsExclusiveMinimum(), "<=", "<", buffer)
+	case SCHEMATYPE_ARRAY:
+
+		items = subschema.(*ArraySchema).Items
+		if needsValidation(items, "rb") {
+
+			buffer.Print("\nvalue.each_with_index do |item, i|")
+			buffer.AddIndentation(1)
+			buffer.Printf("\ncollect_%s_item_violations(path + \"[#{i}]\", item, violations)", name)
+			buffer.AddIndentation(-1)
+			buffer.Print("\nend")
+		}
+	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\nend\n")
+
+	if subschema.GetSchemaType() == SCHEMATYPE_ARRAY && needsValidation(items, "rb") {
+		generateRubyValueValidation(items, failure, name+"_item", false, buffer)
 	}
+}
+
+/*
+	A checkFailure which adds a description of the violation (with the path in 'path', and the offending 'value') to 'violations'.
+	Ruby interpolates "#{" in double quotes, so every "#" of the description is escaped too.
+*/
+func getRubyViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.push(path + \"%s#{value}\")", strings.Replace(getViolationText(keyword, description), "#", "\\#", -1))
+}
+
+/*
+	A checkFailure like getRubyViolation, for writeOnly values, which leaves the value out of the description.
+*/
+func getRubyWriteOnlyViolation(keyword string, description string, statement string) string {
+	return fmt.Sprintf("violations.push(path + \"%s\")", strings.Replace(getWriteOnlyViolationText(keyword, description), "#", "\\#", -1))
+}
+
+/*
+	Generates a method which checks the given [condition] against an instance of [schema].
+	If [collect]ing, the method adds a violation to the given list for each field which doesn't meet the condition
+	(described with the given prefix before its name); otherwise it raises on the first.
+	Constraints on properties are checked by reusing the setter checks against a local "value".
+	Properties which aren't present are not checked.
+*/
+func generateRubyCondition(schema *ObjectSchema, condition *ConditionalSchema, name string, collect bool, buffer *BufferedFormatString) {
 
-	if schema.HasMaximum() {
-		generateRubyRangeCheck(schema.GetMaximum(), "value", "is over the allowable maximum", schema.GetConstraintFormat(), schema.IsExclusiveMaximum(), ">=", ">", buffer)
+	var subschema TypeSchema
+	var failure checkFailure
+
+	if collect {
+		buffer.Printf("\ndef collect_%s_condition_violations(prefix, violations)", name)
+	} else {
+		buffer.Printf("\ndef validate_%s()", name)
 	}
+	buffer.AddIndentation(1)
+
+	for _, propertyName := range condition.RequiredProperties {
+
+		buffer.Printf("\nif(@%s == nil)", ToSnakeCase(propertyName))
+		buffer.AddIndentation(1)
+
+		if collect {
+			buffer.Printf("\nviolations.push(prefix + \"%s: required: is required\")", strings.Replace(sanitizeQuotedString(propertyName), "#", "\\#", -1))
+		} else {
+			buffer.Printf("\nraise StandardError.new(\"Property '%s' is required\")", propertyName)
+		}
 
-	if schema.HasEnum() {
-		generateRubyEnumCheck(schema, buffer, schema.GetEnum(), "", "")
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend\n")
 	}
 
-	if schema.HasMultiple() {
+	for _, propertyName := range condition.GetOrderedPropertyNames() {
+
+		subschema = condition.Properties[propertyName]
+		if !subschema.HasConstraints() {
+			continue
+		}
+
+		failure = failWithStatement
+
+		if collect {
 
-		buffer.Printf("\nif(value %% %f != 0)", schema.GetMultiple())
+			failure = chooseViolation(schema.Properties[propertyName], getRubyViolation, getRubyWriteOnlyViolation)
+			buffer.Printf("\npath = prefix + \"%s\"", strings.Replace(sanitizeQuotedString(propertyName), "#", "\\#", -1))
+		}
+
+		buffer.Printf("\nvalue = @%s", ToSnakeCase(propertyName))
+		buffer.Print("\nunless(value == nil)")
 		buffer.AddIndentation(1)
 
-		buffer.Printf("\nraise StandardError.new(\"Property '#{value}' was not a multiple of %v\")", schema.GetMultiple())
+		generateRubyChecks(subschema, failure, buffer)
 
 		buffer.AddIndentation(-1)
 		buffer.Print("\nend\n")
 	}
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\nend\n")
+}
+
+/*
+	Generates all the checks appropriate for the type of the given [subschema],
+	each of which reports (with [fail]) if 'value' violates a constraint.
+*/
+func generateRubyChecks(subschema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	switch subschema.GetSchemaType() {
+	case SCHEMATYPE_STRING:
+		generateRubyStringSetter(subschema.(*StringSchema), fail, buffer)
+	case SCHEMATYPE_INTEGER:
+		fallthrough
+	case SCHEMATYPE_NUMBER:
+		generateRubyNumericSetter(subschema.(NumericSchemaType), fail, buffer)
+	case SCHEMATYPE_BOOLEAN:
+		generateRubyBooleanSetter(subschema.(*BooleanSchema), fail, buffer)
+	case SCHEMATYPE_ARRAY:
+		generateRubyArraySetter(subschema.(*ArraySchema), fail, buffer)
+	}
 }
 
-func generateRubyObjectSetter(schema *ObjectSchema, buffer *BufferedFormatString) {
+func generateRubyStringSetter(schema *StringSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var enum, pattern *ModelRule
+
+	generateRubyNotCheck(schema, fail, buffer)
+
+	generateRubyRangeChecks(schema, MODELSUBJECT_LENGTH, "value.length", "%d", fail, buffer)
 
-	if !schema.Nullable {
-		generateRubyNullCheck(buffer)
+	// byte lengths are only supported for binary content.
+	if schema.IsBinary() {
+		generateRubyRangeChecks(schema, MODELSUBJECT_BYTE_LENGTH, "value.bytesize", "%d", fail, buffer)
+	}
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateRubyEnumCheck(buffer, enum, "'", "'", fail)
+	}
+
+	pattern = getModelRule(schema, MODELRULE_PATTERN)
+	if pattern != nil {
+
+		buffer.Printf("\nunless(value =~ /%s/)\n", pattern.Value)
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s", fail(pattern.Keyword, fmt.Sprintf("did not match pattern '%s'", pattern.Value), fmt.Sprintf("raise StandardError.new(\"Value '#{value}' did not match pattern '%s'\")", pattern.Value)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend")
 	}
 }
 
-func generateRubyArraySetter(schema *ArraySchema, buffer *BufferedFormatString) {
+func generateRubyNumericSetter(schema NumericSchemaType, fail checkFailure, buffer *BufferedFormatString) {
 
-	if !schema.Nullable {
-		generateRubyNullCheck(buffer)
+	var enum, multiple *ModelRule
+	var format, prefix, postfix string
+
+	format = schema.GetConstraintFormat()
+
+	// decimals accept anything BigDecimal() does; floats are converted by their shortest representation, so 0.1 stays 0.1.
+	if isDecimal(schema.(TypeSchema)) {
+
+		buffer.Print("\nvalue = BigDecimal(value.to_s)")
+
+		prefix = "BigDecimal(\""
+		postfix = "\")"
+		format = prefix + format + postfix
 	}
 
-	if schema.MinItems != nil {
-		generateRubyRangeCheck(*schema.MinItems, "value.Length", "does not have enough items", "%d", false, "<", "", buffer)
+	generateRubyNotCheck(schema.(TypeSchema), fail, buffer)
+
+	generateRubyRangeChecks(schema.(TypeSchema), MODELSUBJECT_VALUE, "value", format, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+		generateRubyEnumCheck(buffer, enum, prefix, postfix, fail)
 	}
 
-	if schema.MaxItems != nil {
-		generateRubyRangeCheck(*schema.MaxItems, "value.Length", "does not have enough items", "%d", false, ">", "", buffer)
+	multiple = getModelRule(schema, MODELRULE_MULTIPLE)
+	if multiple != nil {
+
+		buffer.Printf("\nif(value %% %s%v%s != 0)", prefix, multiple.Value, postfix)
+		buffer.AddIndentation(1)
+
+		buffer.Printf("\n%s", fail(multiple.Keyword, fmt.Sprintf("was not a multiple of %v", multiple.Value), fmt.Sprintf("raise StandardError.new(\"Property '#{value}' was not a multiple of %v\")", multiple.Value)))
+
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend\n")
 	}
 }
 
-func generateRubyRangeCheck(value interface{}, reference, message, format string, exclusive bool, comparator, exclusiveComparator string, buffer *BufferedFormatString) {
+func generateRubyBooleanSetter(schema *BooleanSchema, fail checkFailure, buffer *BufferedFormatString) {
 
-	var compareString string
+	var enum *ModelRule
+	var value interface{}
 
-	if exclusive {
-		compareString = exclusiveComparator
-	} else {
-		compareString = comparator
+	generateRubyNotCheck(schema, fail, buffer)
+
+	enum = getModelRule(schema, MODELRULE_ENUM)
+	if enum != nil {
+
+		value = enum.Value.([]interface{})[0]
+
+		buffer.Printf("\nif(value != %v)", value)
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(enum.Keyword, fmt.Sprintf("must be %v", value), fmt.Sprintf("raise StandardError.new(\"Property '#{value}' must be %v.\")", value)))
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend\n")
+	}
+}
+
+/*
+	Generates code which raises if 'value' matches the "not" subschema of the given [schema].
+	The subschema's checks are run inside a begin block, and the value is only valid if one of them raises.
+*/
+func generateRubyNotCheck(schema TypeSchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	var not *ModelRule
+
+	not = getModelRule(schema, MODELRULE_NOT)
+	if not == nil {
+		return
+	}
+
+	buffer.Print("\nbegin")
+	buffer.AddIndentation(1)
+
+	generateRubyChecks(not.Value.(TypeSchema), failWithStatement, buffer)
+
+	buffer.AddIndentation(-1)
+	buffer.Print("\nrescue StandardError")
+	buffer.Print("\nelse")
+	buffer.AddIndentation(1)
+	buffer.Printf("\n%s", fail(not.Keyword, not.Message, "raise StandardError.new(\"Property '#{value}' matched a schema which it must not match.\")"))
+	buffer.AddIndentation(-1)
+	buffer.Print("\nend\n")
+}
+
+func generateRubyArraySetter(schema *ArraySchema, fail checkFailure, buffer *BufferedFormatString) {
+
+	generateRubyRangeChecks(schema, MODELSUBJECT_ITEMS, "value.length", "%d", fail, buffer)
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_UNIQUE, MODELSUBJECT_ITEMS) {
+
+		buffer.Print("\nif(value.uniq.length != value.length)")
+		buffer.AddIndentation(1)
+		buffer.Printf("\n%s", fail(rule.Keyword, rule.Message, fmt.Sprintf("raise StandardError.new(\"Property '#{value}' %s.\")", rule.Message)))
+		buffer.AddIndentation(-1)
+		buffer.Print("\nend\n")
+	}
+}
+
+/*
+	Generates a check for every range rule of the given [schema] on the given [subject],
+	comparing [reference] to each bound (formatted with [format]).
+*/
+func generateRubyRangeChecks(schema TypeSchema, subject ModelSubject, reference string, format string, fail checkFailure, buffer *BufferedFormatString) {
+
+	for _, rule := range filterModelRules(GetModelRules(schema), MODELRULE_RANGE, subject) {
+		generateRubyRangeCheck(rule, reference, fmt.Sprintf(format, rule.Value), fail, buffer)
 	}
+}
 
-	buffer.Printf("\nif(%s %s "+format+")", reference, compareString, value)
+/*
+	Generates a check which reports (with [fail]) if [reference] violates the given range [rule], with the bound written as [value].
+*/
+func generateRubyRangeCheck(rule *ModelRule, reference string, value string, fail checkFailure, buffer *BufferedFormatString) {
+
+	buffer.Printf("\nif(%s %s %s)", reference, rule.Violation, value)
 	buffer.AddIndentation(1)
 
-	buffer.Printf("\nraise StandardError.new(\"Property '#{value}' %s.\")", message)
+	buffer.Printf("\n%s", fail(rule.Keyword, fmt.Sprintf("%s (%v)", rule.Message, rule.Value), fmt.Sprintf("raise StandardError.new(\"Property '#{value}' %s.\")", rule.Message)))
 
 	buffer.AddIndentation(-1)
 	buffer.Print("\nend\n")
 }
 
 /*
-	Generates code which throws an error if the given [parameter]'s value is not contained in the given [validValues].
+	Generates code which reports (with [fail]) if 'value' is not one of the values of the given enum [rule].
 */
-func generateRubyEnumCheck(schema TypeSchema, buffer *BufferedFormatString, enumValues []interface{}, prefix string, postfix string) {
+func generateRubyEnumCheck(buffer *BufferedFormatString, rule *ModelRule, prefix string, postfix string, fail checkFailure) {
 
+	var enumValues []interface{}
 	var stringValues []string
 	var length int
 
+	enumValues = rule.Value.([]interface{})
 	length = len(enumValues)
 
 	if length <= 0 {
@@ -366,12 +937,22 @@ func generateRubyEnumCheck(schema TypeSchema, buffer *BufferedFormatString, enum
 	buffer.Print("\nunless(validValues.include?(value))")
 	buffer.AddIndentation(1)
 
-	buffer.Print("\nraise StandardError.new(\"Given value '#{value}' was not found in list of acceptable values\")")
+	buffer.Printf("\n%s", fail(rule.Keyword, "was not found in list of acceptable values", "raise StandardError.new(\"Given value '#{value}' was not found in list of acceptable values\")"))
 
 	buffer.AddIndentation(-1)
 	buffer.Print("\nend\n")
 }
 
+/*
+	Generates a YARD "@deprecated" tag if the given schema is deprecated.
+*/
+func generateRubyDeprecation(schema TypeSchema, buffer *BufferedFormatString) {
+
+	if schema.IsDeprecated() {
+		buffer.Print("\n# @deprecated")
+	}
+}
+
 func generateRubyNullCheck(buffer *BufferedFormatString) {
 
 	buffer.Print("\nif(value == nil)")
@@ -0,0 +1,363 @@
+package presilo
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+	Rewrites the golden files with whatever is generated now, rather than comparing against them.
+	Used after an intended change to generated code: "go test -run TestConformance -update", then review the diff.
+	TestTemplateGenerator's golden files are rewritten the same way.
+*/
+var updateGoldens = flag.Bool("update", false, "rewrite the golden files of TestConformance and TestTemplateGenerator")
+
+/*
+	The corpus of schemas is in "schemas", and the code expected from each is in "golden/<language>/<schema>",
+	or "golden/single/<language>/<schema>" when every type is generated into one file.
+*/
+const conformanceDirectory = "testdata/conformance"
+
+/*
+	The module code is generated in, for each language. Every registered generator needs one.
+*/
+var conformanceModules = map[string]string{
+	"go":    "conformance",
+	"js":    "conformance",
+	"java":  "com.example.conformance",
+	"cs":    "Example.Conformance",
+	"rb":    "Example::Conformance",
+	"py":    "conformance",
+	"mysql": "conformance",
+}
+
+/*
+	Modules which are used instead of those in conformanceModules when every type is generated in one file.
+	A single file is named after its module, and file names can't contain ':' on every platform.
+*/
+var conformanceSingleFileModules = map[string]string{
+	"rb": "Conformance",
+}
+
+/*
+	Generates every schema in the corpus with every generator, both one file per type and every type in one file,
+	and compares each file to its golden file, after checking that it's syntactically valid.
+*/
+func TestConformance(test *testing.T) {
+
+	var context *SchemaParseContext
+	var paths []string
+	var name string
+	var err error
+
+	paths, err = filepath.Glob(filepath.Join(conformanceDirectory, "schemas", "*.json"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if len(paths) == 0 {
+		test.Fatal("No schemas found in the conformance corpus")
+	}
+
+	for _, path := range paths {
+
+		name = strings.TrimSuffix(filepath.Base(path), ".json")
+
+		context, err = parseConformanceSchema(path)
+		if err != nil {
+			test.Errorf("Unable to parse '%s': %s", path, err.Error())
+			continue
+		}
+
+		for _, language := range GetGeneratorNames() {
+
+			test.Run(language+"/"+name, func(test *testing.T) {
+				checkConformance(test, context, language, true, filepath.Join(conformanceDirectory, "golden", language, name))
+			})
+
+			test.Run("single/"+language+"/"+name, func(test *testing.T) {
+				checkConformance(test, context, language, false, filepath.Join(conformanceDirectory, "golden", "single", language, name))
+			})
+		}
+	}
+}
+
+/*
+	Generates the given [context] in the given [language], either one file per type or in one file (see GenerateCode),
+	and compares each file to its golden file beneath [goldenPath].
+*/
+func checkConformance(test *testing.T, context *SchemaParseContext, language string, splitFiles bool, goldenPath string) {
+
+	var generator Generator
+	var files map[string]string
+	var module string
+	var found bool
+	var err error
+
+	module, found = conformanceModules[language]
+	if !found {
+		test.Fatalf("No module given for '%s'; add one to conformanceModules", language)
+	}
+
+	if singleFileModule, found := conformanceSingleFileModules[language]; found && !splitFiles {
+		module = singleFileModule
+	}
+
+	generator, _ = GetGenerator(language)
+
+	// generators which always split files would only generate the same files again.
+	if !splitFiles && generator.GetOutputStrategy() == OUTPUTSTRATEGY_SPLIT {
+		test.Skip("Always generates one file per type")
+	}
+
+	files, err = GenerateCode(context, module, language, "\t", false, splitFiles, GeneratorOptions{})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	for _, path := range getSortedFileNames(files) {
+
+		err = checkSyntax(language, generator.GetLineComment(), path, files[path])
+		if err != nil {
+			test.Errorf("%s is not valid: %s", path, err.Error())
+		}
+	}
+
+	if *updateGoldens {
+
+		err = writeGoldenFiles(goldenPath, files)
+		if err != nil {
+			test.Fatal(err)
+		}
+		return
+	}
+
+	compareGoldenFiles(test, goldenPath, files)
+}
+
+/*
+	Parses the schema at the given [path] the way WriteGeneratedCode would be given it,
+	with sources named relative to the corpus so that provenance headers don't depend on where the repository is.
+*/
+func parseConformanceSchema(path string) (*SchemaParseContext, error) {
+
+	var context *SchemaParseContext
+	var err error
+
+	context = NewSchemaParseContext()
+
+	context.SourceRoot, err = filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ParseSchemaFileContinue(path, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return context, LinkSchemas(context)
+}
+
+/*
+	Replaces every golden file beneath [goldenPath] with the given [files], so that files which are no longer generated are removed.
+*/
+func writeGoldenFiles(goldenPath string, files map[string]string) error {
+
+	var path string
+	var err error
+
+	err = os.RemoveAll(goldenPath)
+	if err != nil {
+		return err
+	}
+
+	for name, contents := range files {
+
+		path = filepath.Join(goldenPath, filepath.FromSlash(name))
+
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			return err
+		}
+
+		err = ioutil.WriteFile(path, []byte(contents), 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func compareGoldenFiles(test *testing.T, goldenPath string, files map[string]string) {
+
+	var expected []byte
+	var err error
+
+	for _, name := range getSortedFileNames(files) {
+
+		expected, err = ioutil.ReadFile(filepath.Join(goldenPath, filepath.FromSlash(name)))
+
+		if os.IsNotExist(err) {
+			test.Errorf("%s has no golden file; run with -update to create it", name)
+			continue
+		}
+
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		if string(expected) != files[name] {
+			test.Errorf("%s differs from its golden file (run with -update if intended):\n%s", name, unifiedDiff("golden/"+name, "generated/"+name, string(expected), files[name]))
+		}
+	}
+
+	err = filepath.Walk(goldenPath, func(path string, info os.FileInfo, err error) error {
+
+		var name string
+
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		name, err = filepath.Rel(goldenPath, path)
+		if err != nil {
+			return err
+		}
+
+		if _, found := files[filepath.ToSlash(name)]; !found {
+			test.Errorf("%s is no longer generated; run with -update to remove its golden file", filepath.ToSlash(name))
+		}
+		return nil
+	})
+
+	if err != nil && !os.IsNotExist(err) {
+		test.Fatal(err)
+	}
+}
+
+/*
+	Returns an error if the given generated [code] isn't syntactically valid.
+	Go code is parsed. The standard library has no parsers for the other languages (and presilo has no dependencies),
+	so their code is only checked to have balanced brackets, outside of strings and comments.
+*/
+func checkSyntax(language string, lineComment string, path string, code string) error {
+
+	var err error
+
+	if language == "go" {
+		_, err = parser.ParseFile(token.NewFileSet(), path, code, parser.AllErrors)
+		return err
+	}
+
+	return checkDelimiters(code, lineComment)
+}
+
+/*
+	Returns an error if any bracket in the given [code] is unbalanced, ignoring strings (either quote) and comments.
+	Comments begin with the given [lineComment]; when it's "//", "/*" block comments are understood too.
+*/
+func checkDelimiters(code string, lineComment string) error {
+
+	var source []rune
+	var stack []rune
+	var lines []int
+	var closers map[rune]rune
+	var character, quote rune
+	var line int
+
+	source = []rune(code)
+	closers = map[rune]rune{')': '(', ']': '[', '}': '{'}
+	line = 1
+
+	for i := 0; i < len(source); i++ {
+
+		character = source[i]
+
+		if character == '\n' {
+			line++
+		}
+
+		if quote != 0 {
+
+			if character == '\\' {
+				i++
+			} else if character == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		if len(lineComment) > 0 && hasPrefixAt(source, i, lineComment) {
+
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+			line++
+			continue
+		}
+
+		if lineComment == "//" && hasPrefixAt(source, i, "/*") {
+
+			for i++; i < len(source) && !hasPrefixAt(source, i, "*/"); i++ {
+				if source[i] == '\n' {
+					line++
+				}
+			}
+			i++
+			continue
+		}
+
+		switch character {
+
+		case '"', '\'':
+			quote = character
+
+		case '(', '[', '{':
+			stack = append(stack, character)
+			lines = append(lines, line)
+
+		case ')', ']', '}':
+
+			if len(stack) == 0 || stack[len(stack)-1] != closers[character] {
+				errorMsg := fmt.Sprintf("Unexpected '%c' on line %d", character, line)
+				return errors.New(errorMsg)
+			}
+
+			stack = stack[:len(stack)-1]
+			lines = lines[:len(lines)-1]
+		}
+	}
+
+	if quote != 0 {
+		return errors.New("String is never closed")
+	}
+
+	if len(stack) > 0 {
+		errorMsg := fmt.Sprintf("'%c' on line %d is never closed", stack[len(stack)-1], lines[len(lines)-1])
+		return errors.New(errorMsg)
+	}
+
+	return nil
+}
+
+func hasPrefixAt(source []rune, index int, prefix string) bool {
+
+	for _, character := range prefix {
+
+		if index >= len(source) || source[index] != character {
+			return false
+		}
+		index++
+	}
+	return true
+}
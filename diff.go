@@ -0,0 +1,244 @@
+package presilo
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+/*
+	The number of unchanged lines shown around each change in a unified diff.
+*/
+const DIFF_CONTEXT = 3
+
+/*
+	One line of a diff; unchanged (' '), only in the old text ('-'), or only in the new text ('+').
+*/
+type diffLine struct {
+	kind byte
+	text string
+}
+
+/*
+	Returns a unified diff which turns the [from] text into the [to] text, with the given file names in its header.
+	Returns an empty string if the texts are identical.
+*/
+func unifiedDiff(fromName string, toName string, from string, to string) string {
+
+	var ret bytes.Buffer
+	var lines []diffLine
+	var start, end, fromLine, toLine int
+
+	if from == to {
+		return ""
+	}
+
+	lines = diffLines(splitDiffLines(from), splitDiffLines(to))
+
+	ret.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", fromName, toName))
+
+	for start < len(lines) {
+
+		// skip unchanged lines up to the next change, keeping count of where each text is.
+		for start < len(lines) && lines[start].kind == ' ' {
+			start++
+			fromLine++
+			toLine++
+		}
+
+		if start >= len(lines) {
+			break
+		}
+
+		// changes separated by few enough unchanged lines share one hunk.
+		end = start
+		for i := start; i < len(lines) && i-end <= DIFF_CONTEXT*2; i++ {
+			if lines[i].kind != ' ' {
+				end = i + 1
+			}
+		}
+
+		writeDiffHunk(lines, start, end, fromLine, toLine, &ret)
+
+		for _, line := range lines[start:end] {
+
+			if line.kind != '+' {
+				fromLine++
+			}
+			if line.kind != '-' {
+				toLine++
+			}
+		}
+		start = end
+	}
+
+	return ret.String()
+}
+
+/*
+	Writes the hunk of [lines] which has changes from [start] to [end] (along with surrounding context) to the given [buffer].
+	[fromLine] and [toLine] are the (zero-based) line numbers of each text at [start].
+*/
+func writeDiffHunk(lines []diffLine, start int, end int, fromLine int, toLine int, buffer *bytes.Buffer) {
+
+	var first, last, fromCount, toCount int
+
+	first = start - DIFF_CONTEXT
+	if first < 0 {
+		first = 0
+	}
+
+	last = end + DIFF_CONTEXT
+	if last > len(lines) {
+		last = len(lines)
+	}
+
+	// leading context is always unchanged, so it moves both texts back equally.
+	fromLine -= start - first
+	toLine -= start - first
+
+	for _, line := range lines[first:last] {
+
+		if line.kind != '+' {
+			fromCount++
+		}
+		if line.kind != '-' {
+			toCount++
+		}
+	}
+
+	// an empty range is numbered by the line before it, everything else is one-based.
+	if fromCount > 0 {
+		fromLine++
+	}
+	if toCount > 0 {
+		toLine++
+	}
+
+	buffer.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", fromLine, fromCount, toLine, toCount))
+
+	for _, line := range lines[first:last] {
+
+		buffer.WriteByte(line.kind)
+		buffer.WriteString(line.text)
+
+		if !strings.HasSuffix(line.text, "\n") {
+			buffer.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+/*
+	Returns each line of the given [text], including its line ending; so that a missing newline at the end is a difference like any other.
+*/
+func splitDiffLines(text string) []string {
+
+	var ret []string
+
+	ret = strings.SplitAfter(text, "\n")
+
+	// text which ends in a newline has nothing after it, which isn't a line.
+	if len(ret[len(ret)-1]) == 0 {
+		ret = ret[:len(ret)-1]
+	}
+	return ret
+}
+
+/*
+	Returns the shortest edit which turns [from] into [to], as every line of both (using Myers' algorithm).
+	Only the diagonals each round could reach are kept to walk back through, so this needs O((N+M)+D²) memory for D edits.
+*/
+func diffLines(from []string, to []string) []diffLine {
+
+	var ret []diffLine
+	var trace [][]int
+	var frontier []int
+	var offset, x, y, k, previousK, previousX, previousY int
+
+	offset = len(from) + len(to) + 1
+	frontier = make([]int, offset*2+1)
+
+	// find the furthest each diagonal can reach with each number of edits, until one reaches the end of both.
+	// before each round, the diagonals the last round reached ([-(edits-1), edits-1]) are kept.
+search:
+	for edits := 0; edits < offset; edits++ {
+
+		if edits == 0 {
+			trace = append(trace, nil)
+		} else {
+			trace = append(trace, append([]int(nil), frontier[offset-edits+1:offset+edits]...))
+		}
+
+		for k = -edits; k <= edits; k += 2 {
+
+			if k == -edits || (k != edits && frontier[offset+k-1] < frontier[offset+k+1]) {
+				x = frontier[offset+k+1]
+			} else {
+				x = frontier[offset+k-1] + 1
+			}
+
+			y = x - k
+			for x < len(from) && y < len(to) && from[x] == to[y] {
+				x++
+				y++
+			}
+
+			frontier[offset+k] = x
+
+			if x >= len(from) && y >= len(to) {
+				break search
+			}
+		}
+	}
+
+	// then walk back from the end, recording each line (in reverse).
+	x = len(from)
+	y = len(to)
+
+	for edits := len(trace) - 1; edits >= 0; edits-- {
+
+		// every path begins with whatever both texts start with.
+		previousX = 0
+		previousY = 0
+
+		if edits > 0 {
+
+			// the kept diagonals start at -(edits-1), so diagonal k is at k+edits-1.
+			frontier = trace[edits]
+			k = x - y
+
+			if k == -edits || (k != edits && frontier[k-1+edits-1] < frontier[k+1+edits-1]) {
+				previousK = k + 1
+			} else {
+				previousK = k - 1
+			}
+
+			previousX = frontier[previousK+edits-1]
+			previousY = previousX - previousK
+		}
+
+		for x > previousX && y > previousY {
+			x--
+			y--
+			ret = append(ret, diffLine{kind: ' ', text: from[x]})
+		}
+
+		if edits > 0 {
+
+			if x == previousX {
+				ret = append(ret, diffLine{kind: '+', text: to[previousY]})
+			} else {
+				ret = append(ret, diffLine{kind: '-', text: from[previousX]})
+			}
+		}
+
+		x = previousX
+		y = previousY
+	}
+
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+
+	return ret
+}
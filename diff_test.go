@@ -0,0 +1,160 @@
+package presilo
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+/*
+	One case of TestUnifiedDiff; the diff expected between two texts, without its file header.
+*/
+type unifiedDiffCase struct {
+	name     string
+	from     string
+	to       string
+	expected string
+}
+
+func TestUnifiedDiff(test *testing.T) {
+
+	var cases []unifiedDiffCase
+	var diff string
+
+	cases = []unifiedDiffCase{
+		{
+			name: "identical",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+		},
+		{
+			name:     "distant changes get their own hunks",
+			from:     "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\nn\n",
+			to:       "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nM\nn\nz\n",
+			expected: "@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n d\n e\n@@ -10,5 +10,6 @@\n j\n k\n l\n-m\n+M\n n\n+z\n",
+		},
+		{
+			name:     "nearby changes share a hunk",
+			from:     "a\nb\nc\nd\ne\nf\ng\n",
+			to:       "A\nb\nc\nd\ne\nf\nG\n",
+			expected: "@@ -1,7 +1,7 @@\n-a\n+A\n b\n c\n d\n e\n f\n-g\n+G\n",
+		},
+		{
+			name:     "missing newline",
+			from:     "a\nb\nc\nd",
+			to:       "a\nb\nc\nd\n",
+			expected: "@@ -1,4 +1,4 @@\n a\n b\n c\n-d\n\\ No newline at end of file\n+d\n",
+		},
+		{
+			name:     "added to nothing",
+			from:     "",
+			to:       "x\ny\n",
+			expected: "@@ -0,0 +1,2 @@\n+x\n+y\n",
+		},
+		{
+			name:     "removed everything",
+			from:     "x\ny\n",
+			to:       "",
+			expected: "@@ -1,2 +0,0 @@\n-x\n-y\n",
+		},
+	}
+
+	for _, testCase := range cases {
+
+		diff = unifiedDiff("from", "to", testCase.from, testCase.to)
+
+		if len(testCase.expected) > 0 {
+			testCase.expected = "--- from\n+++ to\n" + testCase.expected
+		}
+
+		if diff != testCase.expected {
+			test.Errorf("Case '%s' gave the wrong diff.\nExpected:\n%s\nActual:\n%s", testCase.name, testCase.expected, diff)
+		}
+	}
+}
+
+/*
+	Checks that the lines diffLines gives for random texts make up both texts, and are the shortest edit between them.
+*/
+func TestDiffLinesIsShortestEdit(test *testing.T) {
+
+	var random *rand.Rand
+	var from, to, fromLines, toLines []string
+	var lines []diffLine
+	var edits int
+
+	random = rand.New(rand.NewSource(1))
+
+	for i := 0; i < 500; i++ {
+
+		from = randomDiffLines(random)
+		to = randomDiffLines(random)
+		lines = diffLines(from, to)
+
+		fromLines = nil
+		toLines = nil
+		edits = 0
+
+		for _, line := range lines {
+
+			if line.kind != '+' {
+				fromLines = append(fromLines, line.text)
+			}
+			if line.kind != '-' {
+				toLines = append(toLines, line.text)
+			}
+			if line.kind != ' ' {
+				edits++
+			}
+		}
+
+		if strings.Join(fromLines, "") != strings.Join(from, "") || strings.Join(toLines, "") != strings.Join(to, "") {
+			test.Fatalf("Diff of %q and %q doesn't make up both texts: %v", from, to, lines)
+		}
+
+		if edits != len(from)+len(to)-2*longestCommonLines(from, to) {
+			test.Fatalf("Diff of %q and %q has %d edits, which isn't the fewest", from, to, edits)
+		}
+	}
+}
+
+/*
+	Returns up to ten lines, from a small enough alphabet that they're often the same.
+*/
+func randomDiffLines(random *rand.Rand) []string {
+
+	var ret []string
+
+	for i := random.Intn(11); i > 0; i-- {
+		ret = append(ret, string(rune('a'+random.Intn(3)))+"\n")
+	}
+	return ret
+}
+
+/*
+	Returns the length of the longest common subsequence of the given lines, the slow and obvious way.
+*/
+func longestCommonLines(from []string, to []string) int {
+
+	var lengths [][]int
+
+	lengths = make([][]int, len(from)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+
+			if from[i] == to[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] > lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	return lengths[0][0]
+}
@@ -0,0 +1,281 @@
+package presilo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+	Vendor extension keywords which override what a generator writes for a schema.
+	Each is prefixed by the name of the generator it applies to, e.g. "x-go-type".
+*/
+const (
+	EXTENSION_TYPE   = "type"
+	EXTENSION_NAME   = "name"
+	EXTENSION_IMPORT = "import"
+	EXTENSION_TAG    = "tag"
+)
+
+/*
+	The generators (and override keywords) which understand overrides.
+	Any other "x-*" keyword is still kept on its schema, but otherwise ignored.
+*/
+var extensionOverrides = map[string][]string{
+	"go":   []string{EXTENSION_TYPE, EXTENSION_NAME, EXTENSION_IMPORT, EXTENSION_TAG},
+	"java": []string{EXTENSION_TYPE, EXTENSION_NAME, EXTENSION_IMPORT},
+	"cs":   []string{EXTENSION_TYPE, EXTENSION_NAME, EXTENSION_IMPORT},
+}
+
+/*
+	Collects every "x-*" keyword of the given [contents] onto the given [schema].
+	Overrides must be strings, except imports, which may also be an array of strings.
+*/
+func parseExtensions(schema TypeSchema, contents map[string]*json.RawMessage) error {
+
+	var extensions map[string]interface{}
+	var value interface{}
+	var err error
+
+	for key, raw := range contents {
+
+		if !strings.HasPrefix(key, "x-") {
+			continue
+		}
+
+		err = json.Unmarshal(*raw, &value)
+		if err != nil {
+			return err
+		}
+
+		err = checkExtensionOverride(key, value)
+		if err != nil {
+			return err
+		}
+
+		if extensions == nil {
+			extensions = make(map[string]interface{})
+		}
+		extensions[key] = value
+		value = nil
+	}
+
+	schema.(interface {
+		setExtensions(map[string]interface{})
+	}).setExtensions(extensions)
+	return nil
+}
+
+/*
+	Returns an error if the given extension [key] is a known override, but its [value] is the wrong type.
+*/
+func checkExtensionOverride(key string, value interface{}) error {
+
+	var list []interface{}
+	var isString, isList bool
+
+	if !isExtensionOverride(key) {
+		return nil
+	}
+
+	_, isString = value.(string)
+	if isString {
+		return nil
+	}
+
+	list, isList = value.([]interface{})
+	if isList && strings.HasSuffix(key, "-"+EXTENSION_IMPORT) {
+
+		for _, item := range list {
+			if _, isString = item.(string); !isString {
+				break
+			}
+		}
+
+		if isString || len(list) == 0 {
+			return nil
+		}
+
+		errorMsg := fmt.Sprintf("Extension keyword '%s' must only contain strings", key)
+		return errors.New(errorMsg)
+	}
+
+	errorMsg := fmt.Sprintf("Extension keyword '%s' must be a string", key)
+	return errors.New(errorMsg)
+}
+
+func isExtensionOverride(key string) bool {
+
+	for language, overrides := range extensionOverrides {
+		for _, override := range overrides {
+
+			if key == "x-"+language+"-"+override {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/*
+	Returns the string value of the given [override] for the given [language], or an empty string if the schema has none.
+*/
+func getExtensionOverride(schema TypeSchema, language string, override string) string {
+
+	var value string
+
+	value, _ = schema.GetExtensions()["x-"+language+"-"+override].(string)
+	return value
+}
+
+/*
+	Returns the name to use for the given property in code generated for the given [language];
+	the property's name override if it has one, or the property name itself if not.
+	Generators still apply their usual casing to the result.
+*/
+func getOverriddenName(schema *ObjectSchema, propertyName string, language string) string {
+
+	var name string
+
+	name = getExtensionOverride(schema.Properties[propertyName], language, EXTENSION_NAME)
+	if len(name) > 0 {
+		return name
+	}
+	return propertyName
+}
+
+/*
+	Returns true if the given [schema] (or the items of it, if it's an array) has its type overridden for the given [language].
+	Generated code never checks the constraints of such schemas, since those checks are written for the schema's own type.
+*/
+func hasTypeOverride(schema TypeSchema, language string) bool {
+
+	for {
+		if len(getExtensionOverride(schema, language, EXTENSION_TYPE)) > 0 {
+			return true
+		}
+
+		if schema.GetSchemaType() != SCHEMATYPE_ARRAY {
+			return false
+		}
+		schema = schema.(*ArraySchema).Items
+	}
+}
+
+/*
+	Returns every import requested by the given [schema], its properties, and their array items, for the given [language].
+	Each import is only returned once.
+*/
+func getExtensionImports(schema *ObjectSchema, language string) []string {
+
+	var ret []string
+	var schemas []TypeSchema
+	var value interface{}
+	var values []interface{}
+	var key string
+
+	key = "x-" + language + "-" + EXTENSION_IMPORT
+	schemas = append(schemas, schema)
+
+	for _, propertyName := range schema.GetOrderedPropertyNames() {
+
+		for property := schema.Properties[propertyName]; ; property = property.(*ArraySchema).Items {
+
+			schemas = append(schemas, property)
+			if property.GetSchemaType() != SCHEMATYPE_ARRAY {
+				break
+			}
+		}
+	}
+
+	for _, subschema := range schemas {
+
+		value = subschema.GetExtensions()[key]
+
+		switch value.(type) {
+		case string:
+			values = []interface{}{value}
+		case []interface{}:
+			values = value.([]interface{})
+		default:
+			continue
+		}
+
+		for _, item := range values {
+			if !arrayContainsString(ret, item.(string)) {
+				ret = append(ret, item.(string))
+			}
+		}
+	}
+
+	return ret
+}
+
+/*
+	Returns a shallow copy of the given [schema] which leaves out every property whose type is overridden for the given [language],
+	along with any conditional constraints on those properties.
+	Useful to determine what generated code will actually need, such as which imports are used.
+*/
+func withoutTypeOverrides(schema *ObjectSchema, language string) *ObjectSchema {
+
+	var ret ObjectSchema
+
+	ret = *schema
+	ret.Properties = make(map[string]TypeSchema)
+	ret.ConstrainedProperties = nil
+	ret.UnconstrainedProperties = nil
+	ret.DependentSchemas = make(map[string]*ConditionalSchema)
+
+	for propertyName, property := range schema.Properties {
+
+		if !hasTypeOverride(property, language) {
+			ret.Properties[propertyName] = property
+		}
+	}
+
+	for _, propertyName := range schema.ConstrainedProperties {
+
+		if _, found := ret.Properties[propertyName]; found {
+			ret.ConstrainedProperties = append(ret.ConstrainedProperties, propertyName)
+		}
+	}
+
+	for _, propertyName := range schema.UnconstrainedProperties {
+
+		if _, found := ret.Properties[propertyName]; found {
+			ret.UnconstrainedProperties = append(ret.UnconstrainedProperties, propertyName)
+		}
+	}
+
+	ret.If = withoutOverriddenConditions(schema.If, &ret)
+	ret.Then = withoutOverriddenConditions(schema.Then, &ret)
+	ret.Else = withoutOverriddenConditions(schema.Else, &ret)
+
+	for propertyName, condition := range schema.DependentSchemas {
+		ret.DependentSchemas[propertyName] = withoutOverriddenConditions(condition, &ret)
+	}
+
+	return &ret
+}
+
+func withoutOverriddenConditions(condition *ConditionalSchema, owner *ObjectSchema) *ConditionalSchema {
+
+	var ret ConditionalSchema
+
+	if condition == nil {
+		return nil
+	}
+
+	ret = *condition
+	ret.Properties = make(map[string]TypeSchema)
+
+	for propertyName, property := range condition.Properties {
+
+		if _, found := owner.Properties[propertyName]; found {
+			ret.Properties[propertyName] = property
+		}
+	}
+
+	return &ret
+}
@@ -1,203 +1,398 @@
 package presilo
 
 import (
-	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
 	"sync"
 )
 
-func WriteGeneratedCode(context *SchemaParseContext, module string, targetPath string, language string, tabstyle string, unsafeModule bool, splitFiles bool) error {
+/*
+	Generates code for every object schema in the given [context] (see GenerateCode), and writes it to files in the given [targetPath].
+	Existing files keep the contents of their protected regions. If any schema fails to generate, nothing is written.
 
-	var schemas []*ObjectSchema
-	var wg sync.WaitGroup
-	var err error
+	Each file is written to a temporary file first, then renamed into place, so no file is ever left half-written.
+	If any files can't be written, the returned error is an OutputFileErrors with one entry per file that failed.
+	Once [ctx] is done, no more files are started; each file which wasn't written has an entry with the context's error.
+*/
+func WriteGeneratedCode(ctx context.Context, context *SchemaParseContext, module string, targetPath string, language string, tabstyle string, unsafeModule bool, splitFiles bool, options GeneratorOptions) error {
+	return writeGeneratedCode(ctx, context, module, targetPath, language, tabstyle, unsafeModule, splitFiles, options, nil, nil)
+}
+
+/*
+	Same as WriteGeneratedCode, except that only the files of the schemas whose IDs are in [only] are written,
+	and they may be renamed with [naming] (see generateCode).
+*/
+func writeGeneratedCode(ctx context.Context, context *SchemaParseContext, module string, targetPath string, language string, tabstyle string, unsafeModule bool, splitFiles bool, options GeneratorOptions, only map[string]bool, naming func(string) string) error {
 
-	// get all object schemas
-	for _, schema := range context.SchemaDefinitions {
+	var files map[string]string
+	var err error
 
-		if(schema.GetSchemaType() == SCHEMATYPE_OBJECT) {
-			schemas = append(schemas, schema.(*ObjectSchema))
-		}
+	files, err = generateCode(context, module, language, tabstyle, unsafeModule, splitFiles, options, only, naming)
+	if err != nil {
+		return err
 	}
 
-	err = generateCode(schemas, module, targetPath, language, tabstyle, unsafeModule, splitFiles, &wg)
-	wg.Wait()
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
 
-	return err
+	return writeGeneratedFiles(ctx, files, targetPath)
 }
 
-func generateCode(schemas []*ObjectSchema, module string, targetPath string, language string, tabstyle string, unsafeModule bool, splitFiles bool, wg *sync.WaitGroup) error {
+/*
+	Generates code for every object schema in the given [context], without writing anything.
+	Returns the contents of every generated file, by its path relative to wherever it would be written (always separated by "/").
+	When [splitFiles] is false, every type goes in one file named after the [module]; otherwise each type has its own file,
+	which loads the files of the types it depends on (see Generator.GenerateImports), along with an index file if the language has one.
+	The given [options] are passed to the generator.
+
+	If generating any schema fails, the returned error is a GenerationErrors, with one error for each schema which failed,
+	and the returned files are only those which were generated completely.
+*/
+func GenerateCode(context *SchemaParseContext, module string, language string, tabstyle string, unsafeModule bool, splitFiles bool, options GeneratorOptions) (map[string]string, error) {
+	return generateCode(context, module, language, tabstyle, unsafeModule, splitFiles, options, nil, nil)
+}
 
-	var schemaGraph *SchemaGraph
-	var objectSchema *ObjectSchema
-	var generator func(*ObjectSchema, string, string) string
-	var moduleValidator func(string) bool
-	var writtenChannel chan string
-	var fileNameChannel chan string
-	var errorChannel chan error
-	var written string
-	var schemaPath string
+/*
+	Same as GenerateCode, except that when [splitFiles] is true, only the schemas whose IDs are in [only] get files (if [only] isn't nil).
+	A single file, or index, always has every type, and is always generated.
+	If [naming] isn't nil, it renames the file of each type (not including its directory), and fails for languages with an index,
+	since their files load each other by name.
+*/
+func generateCode(context *SchemaParseContext, module string, language string, tabstyle string, unsafeModule bool, splitFiles bool, options GeneratorOptions, only map[string]bool, naming func(string) string) (map[string]string, error) {
 
-	schemaGraph = NewSchemaGraph(schemas)
+	var ret map[string]string
+	var errs GenerationErrors
+	var schemas []*ObjectSchema
+	var graph *SchemaGraph
+	var generator Generator
+	var formatter Formatter
+	var singleFile []string
+	var singleFileSchemas []*ObjectSchema
+	var written, fileName, index string
+	var found bool
+	var err error
 
 	// figure out which code generator to use
-	switch language {
-
-	case "go":
-		generator = GenerateGo
-		moduleValidator = ValidateGoModule
-	case "js":
-		generator = GenerateJS
-		moduleValidator = ValidateJSModule
-	case "java":
-		generator = GenerateJava
-		moduleValidator = ValidateJavaModule
-	case "cs":
-		generator = GenerateCSharp
-		moduleValidator = ValidateCSharpModule
-	case "rb":
-		generator = GenerateRuby
-		moduleValidator = ValidateRubyModule
-	case "py":
-		generator = GeneratePython
-		moduleValidator = ValidatePythonModule
-	case "mysql":
-		generator = GenerateMySQL
-		moduleValidator = ValidateMySQLModule
-	default:
-		return errors.New("No valid language specified")
-	}
-
-	if !unsafeModule && !moduleValidator(module) {
+	generator, found = GetGenerator(language)
+	if !found {
+		return nil, errors.New("No valid language specified")
+	}
+
+	if !unsafeModule && !generator.ValidateModule(module) {
 		errorMsg := fmt.Sprintf("Package name '%s' is not valid for language '%s'. Use '-usafemodule' to ignore.", module, language)
-		return errors.New(errorMsg)
+		return nil, errors.New(errorMsg)
 	}
 
-	writtenChannel = make(chan string)
-	errorChannel = make(chan error)
-	defer close(writtenChannel)
-	defer close(errorChannel)
+	formatter, _ = GetFormatter(language)
 
-	// one for the file writer, one for error listener.
-	wg.Add(2)
+	// some generators only make sense one way or the other.
+	switch generator.GetOutputStrategy() {
+	case OUTPUTSTRATEGY_SINGLE:
+		splitFiles = false
+	case OUTPUTSTRATEGY_SPLIT:
+		splitFiles = true
+	}
 
-	// Start writer goroutines based on our split strategy
-	if splitFiles {
+	// get all object schemas, by ID so that they're always ordered the same way.
+	for _, id := range getSortedSchemaIDs(context) {
 
-		fileNameChannel = make(chan string)
-		defer close(fileNameChannel)
-		go writeSplitFiles(writtenChannel, fileNameChannel, errorChannel, wg)
+		schema := context.SchemaDefinitions[id]
+		if schema.GetSchemaType() == SCHEMATYPE_OBJECT {
+			schemas = append(schemas, schema.(*ObjectSchema))
+		}
+	}
 
-	} else {
-		schemaPath = fmt.Sprintf("%s%s%s.%s", targetPath, string(os.PathSeparator), module, language)
-		go writeSingleFile(schemaPath, writtenChannel, errorChannel, wg)
+	graph = NewSchemaGraph(schemas)
+	schemas, _ = graph.GetOrderedSchemas()
+	ret = make(map[string]string)
+
+	if naming != nil && splitFiles {
+
+		fileName, _ = generator.GenerateIndex(schemas, module, tabstyle)
+		if len(fileName) > 0 {
+			errorMsg := fmt.Sprintf("Files of language '%s' load each other by name, so can't be renamed", language)
+			return nil, errors.New(errorMsg)
+		}
 	}
 
-	// write errors to stderr, no matter where they come from.
-	go writeErrors(errorChannel, wg)
+	for _, objectSchema := range schemas {
 
-	// generate schemas, pass to writers.
-	schemas, _ = schemaGraph.GetOrderedSchemas()
+		if splitFiles && only != nil && !only[objectSchema.GetID()] {
+			continue
+		}
 
-	for _, objectSchema = range schemas {
+		written, err = generateSchema(generator, objectSchema, module, tabstyle, options)
+		if err != nil {
+			errs = append(errs, &GenerationError{Schema: objectSchema.GetTitle(), Err: err})
+			continue
+		}
 
-		if splitFiles {
-			schemaPath = fmt.Sprintf("%s%s%s.%s", targetPath, string(os.PathSeparator), objectSchema.GetTitle(), language)
-			fileNameChannel <- schemaPath
+		// a single file already has every type, in dependency order, and is formatted once it's combined.
+		if !splitFiles {
+			singleFile = append(singleFile, written)
+			singleFileSchemas = append(singleFileSchemas, objectSchema)
+			continue
 		}
 
-		written = generator(objectSchema, module, tabstyle)
-		writtenChannel <- written
+		written = generator.GenerateImports(objectSchema, graph.GetDependencies(objectSchema), module, tabstyle) + written
+
+		if formatter != nil {
+
+			written, err = formatter(written)
+			if err != nil {
+				errorMsg := fmt.Sprintf("Unable to format generated code: %s", err.Error())
+				errs = append(errs, &GenerationError{Schema: objectSchema.GetTitle(), Err: errors.New(errorMsg)})
+				continue
+			}
+		}
+
+		fileName = generator.GetFileName(objectSchema, module)
+		if naming != nil {
+			fileName = path.Join(path.Dir(fileName), naming(path.Base(fileName)))
+		}
+
+		fileName += "." + generator.GetFileExtension()
+		ret[fileName] = withProvenanceHeader(generator, []*ObjectSchema{objectSchema}, context.SourceRoot, written)
 	}
 
-	return nil
+	// a single file (or index) missing some of its types would be misleading, so it's only given if every type made it.
+	if !splitFiles && len(errs) == 0 {
+
+		fileName = module + "." + generator.GetFileExtension()
+
+		written, err = combineSingleFile(generator, formatter, singleFile, module)
+		if err != nil {
+			errs = append(errs, &GenerationError{Schema: fileName, Err: err})
+		} else {
+			ret[fileName] = withProvenanceHeader(generator, singleFileSchemas, context.SourceRoot, written)
+		}
+	}
+
+	if splitFiles && len(errs) == 0 {
+
+		fileName, index = generator.GenerateIndex(schemas, module, tabstyle)
+
+		if len(fileName) > 0 && formatter != nil {
+			index, err = formatter(index)
+		}
+
+		// an index which can't be formatted is reported by its file name, since it belongs to no one schema.
+		if err != nil {
+			errorMsg := fmt.Sprintf("Unable to format generated code: %s", err.Error())
+			errs = append(errs, &GenerationError{Schema: fileName, Err: errors.New(errorMsg)})
+		} else if len(fileName) > 0 {
+			ret[fileName+"."+generator.GetFileExtension()] = withProvenanceHeader(generator, schemas, context.SourceRoot, index)
+		}
+	}
+
+	if len(errs) > 0 {
+		return ret, errs
+	}
+	return ret, nil
+}
+
+/*
+	Generates code for the given [schema] with the given [generator],
+	returning an error only if the generator is a FallibleGenerator which failed.
+*/
+func generateSchema(generator Generator, schema *ObjectSchema, module string, tabstyle string, options GeneratorOptions) (string, error) {
+
+	if fallible, isFallible := generator.(FallibleGenerator); isFallible {
+		return fallible.TryGenerate(schema, module, tabstyle, options)
+	}
+	return generator.Generate(schema, module, tabstyle, options), nil
 }
 
 /*
-	Writes incoming strings to incoming file names.
+	Combines the given [code] of every type into one file with the given [generator] (see Generator.CombineFiles),
+	then formats the whole file with the given [formatter], if there is one.
 */
-func writeSplitFiles(source chan string, fileNames chan string, resultError chan error, wg *sync.WaitGroup) {
+func combineSingleFile(generator Generator, formatter Formatter, code []string, module string) (string, error) {
 
-	var schemaPath, contents string
+	var ret string
 	var err error
-	var ok bool
 
-	defer wg.Done()
+	ret, err = generator.CombineFiles(code, module)
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to combine generated code into one file: %s", err.Error())
+		return "", errors.New(errorMsg)
+	}
 
-	for {
+	if formatter == nil {
+		return ret, nil
+	}
 
-		schemaPath, ok = <-fileNames
-		if !ok {
-			return
-		}
+	ret, err = formatter(ret)
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to format generated code: %s", err.Error())
+		return "", errors.New(errorMsg)
+	}
+
+	return ret, nil
+}
+
+func getSortedSchemaIDs(context *SchemaParseContext) []string {
+
+	var ret []string
+
+	for id, _ := range context.SchemaDefinitions {
+		ret = append(ret, id)
+	}
+
+	sort.Strings(ret)
+	return ret
+}
+
+/*
+	Returns the given generated [code] with a provenance header (see generateProvenanceHeader), if the given [generator] has a line comment.
+*/
+func withProvenanceHeader(generator Generator, schemas []*ObjectSchema, sourceRoot string, code string) string {
+
+	if len(generator.GetLineComment()) == 0 {
+		return code
+	}
+	return generateProvenanceHeader(generator.GetLineComment(), schemas, sourceRoot, code) + code
+}
+
+/*
+	Writes each of the given [files] (by relative path) under the given [targetPath], concurrently.
+*/
+func writeGeneratedFiles(ctx context.Context, files map[string]string, targetPath string) error {
+
+	var paths []string
+	var errs []error
+	var fileErrors OutputFileErrors
+	var limiter chan bool
+	var wg sync.WaitGroup
+
+	paths = getSortedFileNames(files)
+	errs = make([]error, len(paths))
+	limiter = make(chan bool, runtime.NumCPU())
+
+	for i, path := range paths {
 
-		contents = <-source
+		wg.Add(1)
+		go func(i int, path string) {
 
-		err = ioutil.WriteFile(schemaPath, []byte(contents), os.ModePerm)
+			defer wg.Done()
 
+			limiter <- true
+			defer func() {
+				<-limiter
+			}()
+
+			errs[i] = ctx.Err()
+			if errs[i] != nil {
+				return
+			}
+
+			errs[i] = writeMergedFile(filepath.Join(targetPath, filepath.FromSlash(path)), files[path])
+		}(i, path)
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
 		if err != nil {
-			resultError <- err
+			fileErrors = append(fileErrors, &OutputFileError{Path: paths[i], Err: err})
 		}
 	}
+
+	if len(fileErrors) > 0 {
+		return fileErrors
+	}
+	return nil
 }
 
 /*
-	Writes all incoming contents from [source] to a file at the given [schemaPath],
-	returning all found errors to [resultError], and returning only once a value is
-	received on [exit], or if the file was unable to be opened.
+	Writes the given generated [contents] to the given [schemaPath].
+	If a file already exists there, the contents of its protected regions are kept (see MergeProtectedRegions),
+	and if they can't be, the existing file is left alone.
+	An existing file which would come out exactly the same (provenance header and all) isn't rewritten at all.
 */
-func writeSingleFile(schemaPath string, source chan string, resultError chan error, wg *sync.WaitGroup) {
+func writeMergedFile(schemaPath string, contents string) error {
 
-	var contents string
-	var outFile *os.File
-	var writer *bufio.Writer
+	var existing []byte
+	var info os.FileInfo
+	var mode os.FileMode
 	var err error
-	var ok bool
 
-	defer wg.Done()
+	mode = 0644
+	existing, err = ioutil.ReadFile(schemaPath)
 
-	outFile, err = os.Create(schemaPath)
-	if err != nil {
-		resultError <- err
-		return
-	}
+	if err == nil {
 
-	writer = bufio.NewWriter(outFile)
+		contents, err = MergeProtectedRegions(string(existing), contents)
+		if err != nil {
+			return err
+		}
+
+		if contents == string(existing) {
+			return nil
+		}
 
-	for {
-		contents, ok = <-source
-		if !ok {
-			break
+		// an updated file keeps whatever permissions it had.
+		info, err = os.Stat(schemaPath)
+		if err != nil {
+			return err
 		}
+		mode = info.Mode()
+
+	} else if os.IsNotExist(err) {
 
-		_, err = writer.Write([]byte(contents))
+		// files may be nested in directories of their own (such as a Java package), which need to exist first.
+		err = os.MkdirAll(filepath.Dir(schemaPath), 0755)
 		if err != nil {
-			resultError <- err
+			return err
 		}
+	} else {
+		return err
 	}
 
-	writer.Flush()
+	return writeFileAtomically(schemaPath, contents, mode)
 }
 
 /*
-  Writes all incoming errors to stderr.
+	Writes the given [contents] to a temporary file next to the given [path], then renames it into place.
+	Either the whole file is written, or [path] is left as it was.
 */
-func writeErrors(intake chan error, wg *sync.WaitGroup) {
+func writeFileAtomically(path string, contents string, mode os.FileMode) error {
 
+	var file *os.File
 	var err error
-	var ok bool
 
-	defer wg.Done()
+	file, err = ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
 
-	for {
-		err, ok = <-intake
-		if !ok {
-			return
-		}
+	_, err = file.WriteString(contents)
 
-		fmt.Fprintf(os.Stderr, err.Error())
+	if err == nil {
+		err = file.Chmod(mode)
 	}
+
+	if err == nil {
+		err = file.Close()
+	} else {
+		file.Close()
+	}
+
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+
+	if err != nil {
+		os.Remove(file.Name())
+	}
+	return err
 }
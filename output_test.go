@@ -0,0 +1,286 @@
+package presilo
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+	Generated code with a provenance header and one protected region, as writeMergedFile would be given it.
+*/
+func getTestGeneratedFile(code string) string {
+
+	code += "\n// presilo:begin Widget\n// presilo:end Widget\n"
+	return generateProvenanceHeader("//", nil, "", code) + code
+}
+
+func TestWriteMergedFileKeepsProtectedRegions(test *testing.T) {
+
+	var path, written string
+	var err error
+
+	path = filepath.Join(test.TempDir(), "widget.go")
+
+	err = ioutil.WriteFile(path, []byte(strings.Replace(getTestGeneratedFile("type Widget struct{}\n"), "presilo:end", "custom\n// presilo:end", 1)), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = writeMergedFile(path, getTestGeneratedFile("type Widget struct{ Name string }\n"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	written = readTestFile(test, path)
+	if !strings.Contains(written, "Name string") || !strings.Contains(written, "custom\n") {
+		test.Errorf("Regenerated file should have the new code, and the old protected region:\n%s", written)
+	}
+}
+
+func TestWriteMergedFileSkipsUnchangedCode(test *testing.T) {
+
+	var path, existing string
+	var err error
+
+	path = filepath.Join(test.TempDir(), "widget.go")
+
+	// only the protected region differs from what's generated, so there's nothing to write.
+	existing = strings.Replace(getTestGeneratedFile("type Widget struct{}\n"), "presilo:end", "custom\n// presilo:end", 1)
+
+	err = ioutil.WriteFile(path, []byte(existing), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = writeMergedFile(path, getTestGeneratedFile("type Widget struct{}\n"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if readTestFile(test, path) != existing {
+		test.Errorf("A file with the same content hash should be left as it is")
+	}
+}
+
+func TestWriteMergedFileRewritesEditedCode(test *testing.T) {
+
+	var path, generated, written string
+	var err error
+
+	path = filepath.Join(test.TempDir(), "widget.go")
+	generated = getTestGeneratedFile("type Widget struct{}\n")
+
+	// the header still has the same content hash, but the code outside of protected regions was edited by hand.
+	err = ioutil.WriteFile(path, []byte(strings.Replace(generated, "struct{}", "struct{ Edited bool }", 1)), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = writeMergedFile(path, generated)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	written = readTestFile(test, path)
+	if written != generated {
+		test.Errorf("A file edited outside of its protected regions should be regenerated, but was:\n%s", written)
+	}
+}
+
+func TestWriteMergedFileRewritesChangedHeader(test *testing.T) {
+
+	var path, generated string
+	var err error
+
+	path = filepath.Join(test.TempDir(), "widget.go")
+	generated = getTestGeneratedFile("type Widget struct{}\n")
+
+	// the code (and so its content hash) is the same, but the schema it came from has changed since.
+	err = ioutil.WriteFile(path, []byte(strings.Replace(generated, "schema-hash: ", "schema-hash: 0", 1)), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = writeMergedFile(path, generated)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if readTestFile(test, path) != generated {
+		test.Errorf("A file whose header has changed should be regenerated")
+	}
+}
+
+func TestWriteGeneratedFilesCancelled(test *testing.T) {
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var targetPath string
+	var errs OutputFileErrors
+	var isOutputFileErrors bool
+	var entries []os.FileInfo
+	var err error
+
+	targetPath = test.TempDir()
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	err = writeGeneratedFiles(ctx, map[string]string{"b.go": "b", "a.go": "a"}, targetPath)
+
+	// every file which wasn't written is reported, in order, with the context's error.
+	errs, isOutputFileErrors = err.(OutputFileErrors)
+	if !isOutputFileErrors || len(errs) != 2 || errs[0].Path != "a.go" || errs[1].Path != "b.go" {
+		test.Fatalf("Expected an OutputFileError for each file, but got: %v", err)
+	}
+
+	for _, fileError := range errs {
+		if fileError.Err != context.Canceled {
+			test.Errorf("Expected '%s' to fail with the context's error, but got: %v", fileError.Path, fileError.Err)
+		}
+	}
+
+	entries, err = ioutil.ReadDir(targetPath)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if len(entries) > 0 {
+		test.Errorf("Nothing should be written once the context is done, but found %d files", len(entries))
+	}
+}
+
+func TestWriteGeneratedFilesErrors(test *testing.T) {
+
+	var targetPath string
+	var errs OutputFileErrors
+	var isOutputFileErrors bool
+	var err error
+
+	targetPath = test.TempDir()
+
+	// a directory can't be made where a file already is, and a file can't replace a directory with something in it.
+	writeTestFile(test, filepath.Join(targetPath, "blocked"), "")
+	writeTestFile(test, filepath.Join(targetPath, "occupied.go", "inside"), "")
+
+	err = writeGeneratedFiles(context.Background(), map[string]string{
+		"written.go":        "written",
+		"blocked/nested.go": "nested",
+		"occupied.go":       "occupied",
+		"nested/written.go": "nested",
+	}, targetPath)
+
+	errs, isOutputFileErrors = err.(OutputFileErrors)
+	if !isOutputFileErrors || len(errs) != 2 || errs[0].Path != "blocked/nested.go" || errs[1].Path != "occupied.go" {
+		test.Fatalf("Expected an OutputFileError for each file which couldn't be written, in order, but got: %v", err)
+	}
+
+	if err.Error() != errs[0].Error()+"\n"+errs[1].Error() || !strings.HasPrefix(errs[0].Error(), "blocked/nested.go: ") {
+		test.Errorf("Expected every file's error, one per line, but got:\n%s", err.Error())
+	}
+
+	// the files which could be written still are.
+	if readTestFile(test, filepath.Join(targetPath, "written.go")) != "written" || readTestFile(test, filepath.Join(targetPath, "nested", "written.go")) != "nested" {
+		test.Errorf("Files which can be written should be, even when others fail")
+	}
+}
+
+func TestWriteFileAtomicallyReplaces(test *testing.T) {
+
+	var path string
+	var info os.FileInfo
+	var err error
+
+	path = filepath.Join(test.TempDir(), "widget.go")
+	writeTestFile(test, path, "a much longer file than the one which replaces it\n")
+
+	err = writeFileAtomically(path, "short\n", 0600)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if readTestFile(test, path) != "short\n" {
+		test.Errorf("Expected the file to be replaced entirely, but was:\n%s", readTestFile(test, path))
+	}
+
+	info, err = os.Stat(path)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if info.Mode().Perm() != 0600 {
+		test.Errorf("Expected the file to have the given mode, but it was %v", info.Mode())
+	}
+
+	assertOnlyTestFiles(test, filepath.Dir(path), "widget.go")
+}
+
+func TestWriteFileAtomicallyFailure(test *testing.T) {
+
+	var directory, path string
+	var err error
+
+	directory = test.TempDir()
+	path = filepath.Join(directory, "widget.go")
+
+	// renaming over a directory which isn't empty fails, after the temporary file is completely written.
+	writeTestFile(test, filepath.Join(path, "inside"), "existing")
+
+	err = writeFileAtomically(path, "contents", 0644)
+	if err == nil {
+		test.Fatalf("Expected replacing a directory to fail")
+	}
+
+	if readTestFile(test, filepath.Join(path, "inside")) != "existing" {
+		test.Errorf("What was at the path should be left as it was")
+	}
+
+	assertOnlyTestFiles(test, directory, "widget.go")
+
+	// a directory which doesn't exist can't even have a temporary file.
+	err = writeFileAtomically(filepath.Join(directory, "missing", "widget.go"), "contents", 0644)
+	if err == nil {
+		test.Errorf("Expected writing into a missing directory to fail")
+	}
+
+	assertOnlyTestFiles(test, directory, "widget.go")
+}
+
+/*
+	Fails the test unless the given [directory] has exactly the given [names] in it (so no temporary files were left behind).
+*/
+func assertOnlyTestFiles(test *testing.T, directory string, names ...string) {
+
+	var entries []os.FileInfo
+	var found []string
+	var err error
+
+	entries, err = ioutil.ReadDir(directory)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	for _, entry := range entries {
+		found = append(found, entry.Name())
+	}
+
+	if strings.Join(found, ",") != strings.Join(names, ",") {
+		test.Errorf("Expected '%s' to have only %v in it, but found %v", directory, names, found)
+	}
+}
+
+func readTestFile(test *testing.T, path string) string {
+
+	var contents []byte
+	var err error
+
+	contents, err = ioutil.ReadFile(path)
+	if err != nil {
+		test.Fatal(err)
+	}
+	return string(contents)
+}
@@ -9,7 +9,9 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
 )
 
 // Parses (and returns) the schema from the given [path].
@@ -50,7 +52,7 @@ func ParseSchemaStream(reader io.Reader, defaultTitle string) (TypeSchema, *Sche
 func ParseSchemaFileContinue(path string, context *SchemaParseContext) (TypeSchema, error) {
 
 	var sourceFile *os.File
-	var name string
+	var name, previousSource string
 	var err error
 
 	path, err = filepath.Abs(path)
@@ -66,16 +68,172 @@ func ParseSchemaFileContinue(path string, context *SchemaParseContext) (TypeSche
 	}
 	defer sourceFile.Close()
 
+	// every schema in this file remembers where it came from.
+	previousSource = context.sourceFile
+	context.sourceFile = path
+	defer func() {
+		context.sourceFile = previousSource
+	}()
+
 	return ParseSchemaStreamContinue(sourceFile, name, context)
 }
 
+/*
+	Parses every ".json" file in the given [directory] (and its subdirectories) into one context, and links them.
+	See ParseSchemaFilesContinue.
+*/
+func ParseSchemaDirectory(directory string) (*SchemaParseContext, error) {
+
+	var paths []string
+	var err error
+
+	directory, err = filepath.Abs(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() && strings.EqualFold(filepath.Ext(path), ".json") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSchemaFiles(paths, directory)
+}
+
+/*
+	Parses every file which matches the given glob [pattern] (as understood by filepath.Match) into one context, and links them.
+	See ParseSchemaFilesContinue.
+*/
+func ParseSchemaGlob(pattern string) (*SchemaParseContext, error) {
+
+	var paths []string
+	var root string
+	var err error
+
+	pattern, err = filepath.Abs(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err = filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// the root is the part of the pattern which contains no wildcards.
+	root = filepath.Dir(pattern)
+	for strings.ContainsAny(root, "*?[") {
+		root = filepath.Dir(root)
+	}
+
+	return parseSchemaFiles(paths, root)
+}
+
+func parseSchemaFiles(paths []string, root string) (*SchemaParseContext, error) {
+
+	var context *SchemaParseContext
+	var err error
+
+	context = NewSchemaParseContext()
+	context.SourceRoot = root
+
+	err = ParseSchemaFilesContinue(paths, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return context, LinkSchemas(context)
+}
+
+/*
+	Parses each of the given [paths] into the given [context], concurrently.
+	Each file is parsed into a context of its own, and those are merged (in the order given) once every file is parsed,
+	so references between the files are left unresolved until LinkSchemas() is called.
+
+	If any files can't be parsed, or define a type (or "#/definitions/" entry) which another file already has,
+	nothing is added to the context, and the returned error is a SchemaFileErrors which has one entry per file that failed.
+*/
+func ParseSchemaFilesContinue(paths []string, context *SchemaParseContext) error {
+
+	var contexts []*SchemaParseContext
+	var merged *SchemaParseContext
+	var errs []error
+	var fileErrors SchemaFileErrors
+	var limiter chan bool
+	var wg sync.WaitGroup
+	var err error
+
+	contexts = make([]*SchemaParseContext, len(paths))
+	errs = make([]error, len(paths))
+	limiter = make(chan bool, runtime.NumCPU())
+
+	for i, path := range paths {
+
+		wg.Add(1)
+		go func(i int, path string) {
+
+			defer wg.Done()
+
+			limiter <- true
+			defer func() {
+				<-limiter
+			}()
+
+			contexts[i] = NewSchemaParseContext()
+			_, errs[i] = ParseSchemaFileContinue(path, contexts[i])
+		}(i, path)
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			fileErrors = append(fileErrors, &SchemaFileError{Path: paths[i], Err: err})
+		}
+	}
+
+	if len(fileErrors) > 0 {
+		return fileErrors
+	}
+
+	// files are merged into a copy first, so that a definition duplicated between files leaves the context as it was.
+	merged = NewSchemaParseContext()
+	merged.merge(context)
+
+	for i, fileContext := range contexts {
+
+		err = merged.merge(fileContext)
+		if err != nil {
+			fileErrors = append(fileErrors, &SchemaFileError{Path: paths[i], Err: err})
+		}
+	}
+
+	if len(fileErrors) > 0 {
+		return fileErrors
+	}
+
+	context.SchemaDefinitions = merged.SchemaDefinitions
+	return nil
+}
+
 /*
 	Same as ParseSchemaStreamContinue, except that instead of a stream, it takes an HTTP URL that will be fetched and parsed.
 */
 func ParseSchemaHTTPContinue(httpPath string, context *SchemaParseContext) (TypeSchema, error) {
 
 	var response *http.Response
-	var baseName string
+	var baseName, previousSource string
 	var err error
 
 	response, err = http.Get(httpPath)
@@ -91,6 +249,12 @@ func ParseSchemaHTTPContinue(httpPath string, context *SchemaParseContext) (Type
 	baseName = filepath.Base(httpPath)
  	baseName = strings.TrimSuffix(baseName, filepath.Ext(baseName))
 
+	previousSource = context.sourceFile
+	context.sourceFile = httpPath
+	defer func() {
+		context.sourceFile = previousSource
+	}()
+
 	return ParseSchemaStreamContinue(response.Body, baseName, context)
 }
 
@@ -160,10 +324,24 @@ func ParseSchema(contentsBytes []byte, defaultTitle string, context *SchemaParse
 
 	// figure out type
 	schemaType, nullable, err = parseSchemaType(contents)
+	if len(schemaType) <= 0 {
+		schemaType = inferConstSchemaType(contents)
+	}
 	if len(schemaType) <= 0 {
 		return nil, errors.New("Schema could not be parsed, type was not specified")
 	}
 
+	if schemaType == "object" || schemaType == "array" {
+
+		for _, keyword := range []string{"const", "not"} {
+
+			if _, present = contents[keyword]; present {
+				errorMsg := fmt.Sprintf("'%s' is only supported on string, number, integer, and boolean schemas", keyword)
+				return nil, errors.New(errorMsg)
+			}
+		}
+	}
+
 	switch schemaType {
 
 	case "boolean":
@@ -193,8 +371,22 @@ func ParseSchema(contentsBytes []byte, defaultTitle string, context *SchemaParse
 		return nil, err
 	}
 
+	err = parseNotSchema(schema, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parseExtensions(schema, contents)
+	if err != nil {
+		return nil, err
+	}
+
 	schema.SetNullable(nullable)
 
+	schema.(interface {
+		setSourceFile(string)
+	}).setSourceFile(context.sourceFile)
+
 	if len(schema.GetTitle()) == 0 {
 		schema.SetTitle(defaultTitle)
 	}
@@ -281,6 +473,165 @@ func parseSchemaType(contents map[string]*json.RawMessage) (string, bool, error)
 	return schemaType, false, nil
 }
 
+/*
+	If the given [contents] have no type, but do have a "const",
+	returns the name of the type implied by the const value.
+	Otherwise returns an empty string.
+*/
+func inferConstSchemaType(contents map[string]*json.RawMessage) string {
+
+	var constMessage *json.RawMessage
+	var decoder *json.Decoder
+	var value interface{}
+	var present bool
+	var err error
+
+	constMessage, present = contents["const"]
+	if !present {
+		return ""
+	}
+
+	// numbers are kept as their literal text, so that large integers aren't mistaken for floats.
+	decoder = json.NewDecoder(bytes.NewReader(*constMessage))
+	decoder.UseNumber()
+
+	err = decoder.Decode(&value)
+	if err != nil {
+		return ""
+	}
+
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+
+		if strings.ContainsAny(value.(json.Number).String(), ".eE") {
+			return "number"
+		}
+		return "integer"
+	}
+
+	return ""
+}
+
+/*
+	Parses the "not" subschema of the given [contents] (if one exists) and attaches it to the given [schema].
+	A "not" subschema borrows the type of the schema it negates, if it doesn't specify one.
+	One which specifies some other type can never match, and is dropped.
+*/
+func parseNotSchema(schema TypeSchema, contents map[string]*json.RawMessage) error {
+
+	var notMessage *json.RawMessage
+	var not TypeSchema
+	var present bool
+	var err error
+
+	notMessage, present = contents["not"]
+	if !present {
+		return nil
+	}
+
+	not, err = parseScalarSubschema(notMessage, schema, schema.GetTitle())
+	if err != nil {
+		return err
+	}
+
+	if not.GetSchemaType() != schema.GetSchemaType() {
+
+		// an integer is also a number, so the two can't be told apart by type alone.
+		if isNumericSchemaType(not.GetSchemaType()) && isNumericSchemaType(schema.GetSchemaType()) {
+			errorMsg := fmt.Sprintf("Schema of type '%s' contains a 'not' constraint of type '%s', which is not supported\n", getScalarSchemaTypeName(schema.GetSchemaType()), getScalarSchemaTypeName(not.GetSchemaType()))
+			return errors.New(errorMsg)
+		}
+
+		// no value of this schema's type can ever match a subschema of another type, so the constraint always holds.
+		return nil
+	}
+
+	schema.(interface {
+		setNot(TypeSchema)
+	}).setNot(not)
+	return nil
+}
+
+/*
+	Returns true if the given [schemaType] is an integer or a number.
+*/
+func isNumericSchemaType(schemaType SchemaType) bool {
+	return schemaType == SCHEMATYPE_INTEGER || schemaType == SCHEMATYPE_NUMBER
+}
+
+/*
+	Parses the given [contents] as a scalar schema which constrains the same value as the given [owner].
+	The owner's type is used if the contents don't specify a type.
+	This is used for subschemas which only constrain a value that's already been described elsewhere,
+	such as "not", or the properties of a conditional schema.
+
+	These are parsed in a scratch context, so that they never get mistaken for (or overwrite) actual definitions.
+*/
+func parseScalarSubschema(contents *json.RawMessage, owner TypeSchema, defaultTitle string) (TypeSchema, error) {
+
+	var subschemaContents map[string]*json.RawMessage
+	var typeMessage json.RawMessage
+	var subschema TypeSchema
+	var subschemaBytes []byte
+	var typeName string
+	var present bool
+	var err error
+
+	typeName = getScalarSchemaTypeName(owner.GetSchemaType())
+
+	err = json.Unmarshal(*contents, &subschemaContents)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, present = subschemaContents["type"]; !present {
+		typeMessage = json.RawMessage(fmt.Sprintf("\"%s\"", typeName))
+		subschemaContents["type"] = &typeMessage
+	}
+
+	subschemaBytes, err = json.Marshal(subschemaContents)
+	if err != nil {
+		return nil, err
+	}
+
+	subschema, err = ParseSchema(subschemaBytes, defaultTitle, NewSchemaParseContext())
+	if err != nil {
+		return nil, err
+	}
+
+	// checks are generated against the owner's value, so integers need to share its width.
+	if subschema.GetSchemaType() == SCHEMATYPE_INTEGER && owner.GetSchemaType() == SCHEMATYPE_INTEGER {
+
+		err = subschema.(*IntegerSchema).matchWidth(owner.(*IntegerSchema))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// likewise, binary strings need to stay binary, and decimals need to stay decimals.
+	if subschema.GetSchemaType() == SCHEMATYPE_STRING && owner.GetSchemaType() == SCHEMATYPE_STRING {
+
+		err = subschema.(*StringSchema).matchBinary(owner.(*StringSchema))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if subschema.GetSchemaType() == SCHEMATYPE_NUMBER && owner.GetSchemaType() == SCHEMATYPE_NUMBER {
+
+		err = subschema.(*NumberSchema).matchDecimal(owner.(*NumberSchema))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return subschema, nil
+}
+
 /*
 	Parses any definitions present in the given [contents], and
 */
@@ -411,6 +762,55 @@ func findSchemaResolution(schema TypeSchema, context *SchemaParseContext) (TypeS
 	return ret, nil
 }
 
+/*
+	Returns the keys of the given json object [message], in the order they appear.
+	Returns no keys if the message is nil.
+*/
+func getJsonKeyOrder(message *json.RawMessage) ([]string, error) {
+
+	var decoder *json.Decoder
+	var token json.Token
+	var value json.RawMessage
+	var ret []string
+	var err error
+
+	if message == nil {
+		return ret, nil
+	}
+
+	decoder = json.NewDecoder(bytes.NewReader(*message))
+
+	token, err = decoder.Token()
+	if err != nil {
+		return ret, err
+	}
+
+	if token != json.Delim('{') {
+		return ret, errors.New("Expected a json object")
+	}
+
+	for decoder.More() {
+
+		token, err = decoder.Token()
+		if err != nil {
+			return ret, err
+		}
+
+		// duplicate keys are only counted where they first appear.
+		if !arrayContainsString(ret, token.(string)) {
+			ret = append(ret, token.(string))
+		}
+
+		// skip the value, whatever it is.
+		err = decoder.Decode(&value)
+		if err != nil {
+			return ret, err
+		}
+	}
+
+	return ret, nil
+}
+
 func getJsonString(source map[string]*json.RawMessage, key string) (string, error) {
 
 	var ret string
@@ -0,0 +1,176 @@
+package presilo
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+	Sets of schema files: "schemas" reference each other (and have a file which isn't a schema),
+	"duplicate" define the same type twice, and one of "invalid" can't be parsed.
+*/
+const parsingDirectory = "testdata/parsing"
+
+func TestParseNotOfAnotherType(test *testing.T) {
+
+	var schema TypeSchema
+	var err error
+
+	schema, err = ParseSchema([]byte(`{"type": "string", "not": {"type": "integer", "minimum": 3}}`), "name", NewSchemaParseContext())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if schema.GetNot() != nil {
+		test.Errorf("Expected a 'not' of another type to be dropped, since it can never match")
+	}
+
+	schema, err = ParseSchema([]byte(`{"type": "string", "not": {"maxLength": 3}}`), "name", NewSchemaParseContext())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if schema.GetNot() == nil {
+		test.Errorf("Expected a 'not' which borrows its owner's type to be kept")
+	}
+}
+
+func TestParseSubschemaTypeErrors(test *testing.T) {
+
+	var err error
+
+	invalid := map[string]string{
+		"numeric not":    `{"type": "integer", "not": {"type": "number", "minimum": 3}}`,
+		"condition type": `{"title": "Thing", "type": "object", "properties": {"code": {"type": "string"}}, "if": {"properties": {"code": {"type": "integer"}}}, "then": {"required": ["code"]}}`,
+	}
+
+	for name, contents := range invalid {
+
+		_, err = ParseSchema([]byte(contents), "thing", NewSchemaParseContext())
+		if err == nil {
+			test.Errorf("Schema with a mismatched %s should fail to parse", name)
+		}
+	}
+}
+
+func TestParseSchemaDirectory(test *testing.T) {
+
+	var context *SchemaParseContext
+	var owner, address TypeSchema
+	var err error
+
+	context, err = ParseSchemaDirectory(filepath.Join(parsingDirectory, "schemas"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	owner = context.SchemaDefinitions["Owner"]
+	address = context.SchemaDefinitions["Address"]
+
+	if owner == nil || address == nil {
+		test.Fatalf("Expected every schema in the directory and its subdirectories to be parsed")
+	}
+
+	// references between files are linked once every file is parsed.
+	if owner.(*ObjectSchema).Properties["home"] != address {
+		test.Errorf("Expected Owner's reference to be linked to the Address from another file")
+	}
+
+	if !filepath.IsAbs(context.SourceRoot) || filepath.Base(context.SourceRoot) != "schemas" {
+		test.Errorf("Source root should be the directory, but was '%s'", context.SourceRoot)
+	}
+
+	if filepath.Base(address.GetSourceFile()) != "address.json" {
+		test.Errorf("Expected each schema to know the file it came from, but Address came from '%s'", address.GetSourceFile())
+	}
+}
+
+func TestParseSchemaGlob(test *testing.T) {
+
+	var context *SchemaParseContext
+	var err error
+
+	context, err = ParseSchemaGlob(filepath.Join(parsingDirectory, "schemas", "*", "*.json"))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if context.SchemaDefinitions["Address"] == nil || context.SchemaDefinitions["Owner"] != nil {
+		test.Errorf("Expected only the files matching the pattern to be parsed")
+	}
+
+	// the root is everything before the first wildcard.
+	if filepath.Base(context.SourceRoot) != "schemas" {
+		test.Errorf("Source root should be the part of the pattern without wildcards, but was '%s'", context.SourceRoot)
+	}
+
+	// a pattern which leaves a reference unresolved can't be linked.
+	_, err = ParseSchemaGlob(filepath.Join(parsingDirectory, "schemas", "*.json"))
+	if err == nil {
+		test.Errorf("Expected a reference to a schema outside of the pattern to fail to link")
+	}
+}
+
+func TestParseSchemaFilesContinueErrors(test *testing.T) {
+
+	var context *SchemaParseContext
+	var errs SchemaFileErrors
+	var isSchemaFileErrors bool
+	var err error
+
+	context = NewSchemaParseContext()
+
+	err = ParseSchemaFilesContinue([]string{
+		filepath.Join(parsingDirectory, "invalid", "address.json"),
+		filepath.Join(parsingDirectory, "invalid", "untyped.json"),
+		filepath.Join(parsingDirectory, "invalid", "missing.json"),
+	}, context)
+
+	errs, isSchemaFileErrors = err.(SchemaFileErrors)
+	if !isSchemaFileErrors || len(errs) != 2 {
+		test.Fatalf("Expected an error for each file which couldn't be parsed, but got: %v", err)
+	}
+
+	if filepath.Base(errs[0].Path) != "untyped.json" || filepath.Base(errs[1].Path) != "missing.json" {
+		test.Errorf("Expected errors in the order the files were given, but got: %v", err)
+	}
+
+	if len(context.SchemaDefinitions) > 0 {
+		test.Errorf("Nothing should be added to the context when any file fails, but it has %d schemas", len(context.SchemaDefinitions))
+	}
+}
+
+func TestParseDuplicateDefinitions(test *testing.T) {
+
+	var context *SchemaParseContext
+	var errs SchemaFileErrors
+	var isSchemaFileErrors bool
+	var path string
+	var err error
+
+	_, err = ParseSchemaDirectory(filepath.Join(parsingDirectory, "duplicate"))
+
+	// the type which is defined second is the one reported, along with where it was defined first.
+	errs, isSchemaFileErrors = err.(SchemaFileErrors)
+	if !isSchemaFileErrors || len(errs) != 1 || filepath.Base(errs[0].Path) != "b.json" {
+		test.Fatalf("Expected one error for the file which defines 'Address' again, but got: %v", err)
+	}
+
+	if !strings.Contains(errs[0].Error(), "'Address'") || !strings.Contains(errs[0].Error(), "a.json") {
+		test.Errorf("Expected the error to name the duplicate, and where it was first defined, but got: %v", err)
+	}
+
+	// the same file given twice defines nothing new, and properties of the same name in different files never conflict.
+	context = NewSchemaParseContext()
+	path = filepath.Join(parsingDirectory, "schemas", "nested", "address.json")
+
+	err = ParseSchemaFilesContinue([]string{path, path, filepath.Join(parsingDirectory, "schemas", "owner.json")}, context)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if context.SchemaDefinitions["Address"] == nil || context.SchemaDefinitions["Owner"] == nil {
+		test.Errorf("Expected every schema to be parsed")
+	}
+}
@@ -0,0 +1,26 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: arrays.json (id: Point)
+// source: arrays.json (id: Arrays)
+// schema-hash: d6daf6a07ea9795d15e61bb38d0976e2a6031c93659ea2ca7b76bd88372b86e0
+// content-hash: a6b65eb1cd38e4c5e82a4ad53e5ea2b842d032526a8e634060642322a1fbd365
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Point.js");
+	require("./Arrays.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
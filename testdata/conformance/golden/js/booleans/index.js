@@ -0,0 +1,24 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: booleans.json (id: Booleans)
+// schema-hash: 72b3ce63bff9ea66fc0d24762e9224965933053f8bc9b8b005b17cc5458714a6
+// content-hash: e1490d6704b2f787c2e371c33414f9961fab1f157145e6ca33e2460c5b818171
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Booleans.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
@@ -0,0 +1,24 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: conditionals.json (id: Conditionals)
+// schema-hash: c37013c865a38da0f7d48b2aaa01b2a9ef00a37e4c40d8236763093015830076
+// content-hash: 1fe9f135b52a9fd685841c9d6a24f0a89e572b02839d8382dd98d722623b06bb
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Conditionals.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
@@ -0,0 +1,24 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: extensions.json (id: Extensions)
+// schema-hash: 88d4d65db9a514ea59fe7c0a3f5f00784d0f01e7b4c59f58a3334a1b99215395
+// content-hash: 07ce79dcf3171478331f79ed4fa10aa2dabda3914318da141b875b10f194ceb6
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Extensions.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
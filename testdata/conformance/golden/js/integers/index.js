@@ -0,0 +1,24 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: integers.json (id: Integers)
+// schema-hash: 044ce8803fb13cff74b2e4d1c87f4c8c3257195034c622d0964ca28fb661565a
+// content-hash: dc830f3566295fca3e9ba53c23ce92f68701606a5876733fd9ef68b6a9483f99
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Integers.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
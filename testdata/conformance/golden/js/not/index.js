@@ -0,0 +1,24 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: not.json (id: Negations)
+// schema-hash: 1d954bbd1d7d024f67c9728b3f4fa94f48eaa09422fcfb9a5842b13e08d29605
+// content-hash: eb6f9fb05f93322c8354c5d306a315d56c24142ee2f9cd77cdbb6d59fbbea49f
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Negations.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
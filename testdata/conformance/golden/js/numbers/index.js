@@ -0,0 +1,24 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: numbers.json (id: Numbers)
+// schema-hash: 13b32b176adc211443b8d04fcf49ef3bbd814f3c54b03959e903ed3bdc516ea8
+// content-hash: 0da71ba488e02b38ea5821cdf13b2bebd346289933a451e4494e353fff61a6cf
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Numbers.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
@@ -0,0 +1,28 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: objects.json (id: Address)
+// source: objects.json (id: Owner)
+// source: objects.json (id: Objects)
+// schema-hash: 5a2cba71c0fab28db4a418b689d3ded3da8ee75911da1fbb1688c66fd6577d80
+// content-hash: af07d7c07c818a93b4c00b3327c7382420b392d40b1816f83bdfc56521f8c88d
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Address.js");
+	require("./Owner.js");
+	require("./Objects.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
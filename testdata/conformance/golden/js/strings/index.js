@@ -0,0 +1,24 @@
+// Code generated by presilo. DO NOT EDIT.
+// source: strings.json (id: Strings)
+// schema-hash: 11a8dc935ae1ee5f41f4065a3102e7c40ab8e1e4e9a487f0212af479f63d6de7
+// content-hash: 95cb2605c93e18d4d932a5ba25a62ab90a0154e44243caa6bda00ddb17076863
+
+
+if(typeof(conformance) === "undefined")
+{
+	conformance = {}
+}
+else
+{
+	conformance = conformance || {}
+}
+
+if(typeof(require) !== "undefined")
+{
+	require("./Strings.js");
+}
+
+if(typeof(module) !== "undefined")
+{
+	module.exports = conformance;
+}
@@ -0,0 +1,12 @@
+# Code generated by presilo. DO NOT EDIT.
+# source: objects.json (id: Address)
+# source: objects.json (id: Owner)
+# source: objects.json (id: Objects)
+# schema-hash: 5a2cba71c0fab28db4a418b689d3ded3da8ee75911da1fbb1688c66fd6577d80
+# content-hash: 94f988987475e31158297ea64a031777a5a0ca3f3b26a414f6cedbc520a08b7d
+
+from .address import Address
+from .owner import Owner
+from .objects import Objects
+
+__all__ = ["Address", "Owner", "Objects"]
@@ -0,0 +1,505 @@
+package presilo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+	presilo has no dependencies outside the standard library, which has no YAML parser.
+	So project files are read with this one, which only understands the parts of YAML that configuration needs:
+	block mappings and sequences (nested by indentation), flow sequences and mappings ("[a, b]", "{a: b}"),
+	plain and quoted scalars, and comments. Anchors, aliases, tags, block scalars, and multiple documents are errors.
+
+	Values are decoded the same way encoding/json decodes into an interface{}, except that numbers are json.Numbers,
+	so that a document can be marshalled to json and decoded like any json file.
+*/
+
+var yamlNumberPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+/*
+	One line of a YAML document, without its indentation or comment.
+*/
+type yamlLine struct {
+	number int
+	indent int
+	text   string
+}
+
+/*
+	Parses the given YAML [contents] (see above) into maps, slices, and scalars.
+*/
+func parseYAML(contents []byte) (interface{}, error) {
+
+	var lines []*yamlLine
+	var value interface{}
+	var next int
+	var err error
+
+	lines, err = getYAMLLines(string(contents))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	value, next, err = parseYAMLNode(lines, 0, lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+
+	if next < len(lines) {
+		return nil, getYAMLError(lines[next], "Unexpected indentation")
+	}
+
+	return value, nil
+}
+
+/*
+	Returns every line of the given [contents] which isn't blank or only a comment, with comments removed.
+*/
+func getYAMLLines(contents string) ([]*yamlLine, error) {
+
+	var ret []*yamlLine
+	var line *yamlLine
+	var text string
+
+	for i, raw := range strings.Split(contents, "\n") {
+
+		line = &yamlLine{number: i + 1}
+
+		text = strings.TrimRight(removeYAMLComment(raw), " \t\r")
+		line.text = strings.TrimLeft(text, " ")
+		line.indent = len(text) - len(line.text)
+
+		if len(line.text) == 0 || (len(ret) == 0 && line.text == "---") {
+			continue
+		}
+
+		if strings.HasPrefix(line.text, "\t") {
+			return nil, getYAMLError(line, "Tabs can't be used for indentation")
+		}
+
+		if line.text == "---" || line.text == "..." {
+			return nil, getYAMLError(line, "Only one document is supported")
+		}
+
+		ret = append(ret, line)
+	}
+
+	return ret, nil
+}
+
+/*
+	Returns the given [line] without any comment; a '#' which begins the line or follows whitespace, outside of quotes.
+*/
+func removeYAMLComment(line string) string {
+
+	var quote byte
+
+	for i := 0; i < len(line); i++ {
+
+		switch {
+		case quote == '"' && line[i] == '\\':
+			i++
+		case quote != 0 && line[i] == quote:
+			quote = 0
+		case quote != 0:
+		case (line[i] == '"' || line[i] == '\'') && (i == 0 || strings.ContainsRune(" \t[{,:-", rune(line[i-1]))):
+			quote = line[i]
+		case line[i] == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+/*
+	Parses the node which begins at [lines][i], which is at the given [indent].
+	Returns its value, and the index of the first line after it.
+*/
+func parseYAMLNode(lines []*yamlLine, i int, indent int) (interface{}, int, error) {
+
+	var value interface{}
+	var err error
+
+	if isYAMLSequenceItem(lines[i].text) {
+		return parseYAMLSequence(lines, i, indent)
+	}
+
+	if _, _, isPair := splitYAMLPair(lines[i].text); isPair {
+		return parseYAMLMapping(lines, i, indent)
+	}
+
+	value, err = parseYAMLScalar(lines[i].text)
+	if err != nil {
+		return nil, i, getYAMLError(lines[i], err.Error())
+	}
+	return value, i + 1, nil
+}
+
+func parseYAMLSequence(lines []*yamlLine, i int, indent int) (interface{}, int, error) {
+
+	var ret []interface{}
+	var item interface{}
+	var text string
+	var err error
+
+	ret = []interface{}{}
+
+	for i < len(lines) && lines[i].indent == indent && isYAMLSequenceItem(lines[i].text) {
+
+		text = strings.TrimLeft(lines[i].text[1:], " ")
+
+		if len(text) == 0 {
+			item, i, err = parseYAMLChild(lines, i, indent)
+		} else {
+			// whatever follows the dash is a node of its own, indented to where it begins (such as the first key of a mapping).
+			lines[i] = &yamlLine{number: lines[i].number, indent: indent + len(lines[i].text) - len(text), text: text}
+			item, i, err = parseYAMLNode(lines, i, lines[i].indent)
+		}
+
+		if err != nil {
+			return nil, i, err
+		}
+		ret = append(ret, item)
+	}
+
+	if i < len(lines) && lines[i].indent > indent {
+		return nil, i, getYAMLError(lines[i], "Unexpected indentation")
+	}
+	return ret, i, nil
+}
+
+func parseYAMLMapping(lines []*yamlLine, i int, indent int) (interface{}, int, error) {
+
+	var ret map[string]interface{}
+	var key, text string
+	var value interface{}
+	var isPair, found bool
+	var line *yamlLine
+	var err error
+
+	ret = make(map[string]interface{})
+
+	for i < len(lines) && lines[i].indent == indent {
+
+		line = lines[i]
+
+		key, text, isPair = splitYAMLPair(line.text)
+		if !isPair {
+			return nil, i, getYAMLError(line, "Expected a key, followed by ':'")
+		}
+
+		_, found = ret[key]
+		if found {
+			return nil, i, getYAMLError(line, fmt.Sprintf("Key '%s' is given more than once", key))
+		}
+
+		if len(text) == 0 {
+			value, i, err = parseYAMLChild(lines, i, indent)
+		} else {
+			value, err = parseYAMLScalar(text)
+			i++
+		}
+
+		if err != nil {
+			if _, isYAMLError := err.(*yamlError); !isYAMLError {
+				err = getYAMLError(line, err.Error())
+			}
+			return nil, i, err
+		}
+		ret[key] = value
+	}
+
+	if i < len(lines) && lines[i].indent > indent {
+		return nil, i, getYAMLError(lines[i], "Unexpected indentation")
+	}
+	return ret, i, nil
+}
+
+/*
+	Parses the node which belongs to the key or dash on [lines][i], which is at the given [indent].
+	The node is on the lines after it; indented further, or a sequence at the same indentation (which YAML allows for mapping values).
+	Nothing at all is null.
+*/
+func parseYAMLChild(lines []*yamlLine, i int, indent int) (interface{}, int, error) {
+
+	var next int
+
+	next = i + 1
+
+	if next < len(lines) && (lines[next].indent > indent || (lines[next].indent == indent && isYAMLSequenceItem(lines[next].text) && !isYAMLSequenceItem(lines[i].text))) {
+		return parseYAMLNode(lines, next, lines[next].indent)
+	}
+	return nil, next, nil
+}
+
+func isYAMLSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+/*
+	Splits the given [text] into a key and whatever follows its ':' (which may be nothing),
+	returning false if the text isn't a key and value.
+*/
+func splitYAMLPair(text string) (string, string, bool) {
+
+	var key string
+	var end int
+	var err error
+
+	// quoted keys end at their closing quote.
+	if strings.HasPrefix(text, "\"") || strings.HasPrefix(text, "'") {
+
+		end = findYAMLQuoteEnd(text)
+		if end < 0 || !strings.HasPrefix(text[end:], ":") {
+			return "", "", false
+		}
+
+		key, err = unquoteYAML(text[:end])
+		if err != nil {
+			return "", "", false
+		}
+		text = text[end:]
+		end = 0
+	} else {
+
+		end = strings.Index(text, ": ")
+		if end < 0 && strings.HasSuffix(text, ":") {
+			end = len(text) - 1
+		}
+
+		if end <= 0 || strings.ContainsAny(text[:1], "[{") || isYAMLSequenceItem(text) {
+			return "", "", false
+		}
+		key = strings.TrimRight(text[:end], " ")
+	}
+
+	if end+1 < len(text) && text[end+1] != ' ' {
+		return "", "", false
+	}
+	return key, strings.TrimSpace(text[end+1:]), true
+}
+
+/*
+	Returns the index just after the closing quote of the quoted scalar which begins the given [text], or -1 if it never closes.
+*/
+func findYAMLQuoteEnd(text string) int {
+
+	for i := 1; i < len(text); i++ {
+
+		if text[0] == '"' && text[i] == '\\' {
+			i++
+			continue
+		}
+
+		if text[i] != text[0] {
+			continue
+		}
+
+		// two single quotes are one escaped quote.
+		if text[0] == '\'' && i+1 < len(text) && text[i+1] == '\'' {
+			i++
+			continue
+		}
+		return i + 1
+	}
+
+	return -1
+}
+
+/*
+	Parses one scalar (or flow collection), which is everything on a line after its key or dash.
+*/
+func parseYAMLScalar(text string) (interface{}, error) {
+
+	var value interface{}
+	var rest string
+	var err error
+
+	switch {
+	case strings.HasPrefix(text, "[") || strings.HasPrefix(text, "{"):
+
+		value, rest, err = parseYAMLFlow(text)
+		if err == nil && len(strings.TrimSpace(rest)) > 0 {
+			errorMsg := fmt.Sprintf("Unexpected '%s' after collection", strings.TrimSpace(rest))
+			err = errors.New(errorMsg)
+		}
+		return value, err
+
+	case strings.HasPrefix(text, "\"") || strings.HasPrefix(text, "'"):
+
+		if findYAMLQuoteEnd(text) != len(text) {
+			return nil, errors.New("Quoted values must end with their closing quote")
+		}
+		return unquoteYAML(text)
+
+	case strings.ContainsAny(text[:1], "&*!|>%@`"):
+		errorMsg := fmt.Sprintf("Values beginning with '%s' aren't supported", text[:1])
+		return nil, errors.New(errorMsg)
+	}
+
+	return parsePlainYAMLScalar(text), nil
+}
+
+/*
+	Returns the value of an unquoted scalar; a boolean, null, a number, or otherwise a string.
+*/
+func parsePlainYAMLScalar(text string) interface{} {
+
+	switch text {
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	case "null", "Null", "NULL", "~":
+		return nil
+	}
+
+	if yamlNumberPattern.MatchString(text) {
+		return json.Number(text)
+	}
+	return text
+}
+
+/*
+	Parses the flow collection (or scalar inside one) at the beginning of the given [text],
+	returning its value, and whatever follows it.
+*/
+func parseYAMLFlow(text string) (interface{}, string, error) {
+
+	var sequence []interface{}
+	var mapping map[string]interface{}
+	var value interface{}
+	var key string
+	var closer byte
+	var end int
+	var err error
+
+	text = strings.TrimLeft(text, " ")
+
+	if len(text) == 0 {
+		return nil, "", errors.New("Collection never ends")
+	}
+
+	// a scalar ends at whatever ends its entry.
+	if text[0] != '[' && text[0] != '{' {
+
+		if text[0] == '"' || text[0] == '\'' {
+
+			end = findYAMLQuoteEnd(text)
+			if end < 0 {
+				return nil, "", errors.New("Quoted value never ends")
+			}
+
+			value, err = unquoteYAML(text[:end])
+			return value, text[end:], err
+		}
+
+		end = strings.IndexAny(text, ",]}:")
+		if end < 0 {
+			return nil, "", errors.New("Collection never ends")
+		}
+
+		// a colon only ends a key when it's followed by a space (or ends the key outright).
+		for text[end] == ':' && end+1 < len(text) && !strings.ContainsRune(" ,]}", rune(text[end+1])) {
+
+			next := strings.IndexAny(text[end+1:], ",]}:")
+			if next < 0 {
+				return nil, "", errors.New("Collection never ends")
+			}
+			end += next + 1
+		}
+
+		return parsePlainYAMLScalar(strings.TrimSpace(text[:end])), text[end:], nil
+	}
+
+	closer = ']'
+	if text[0] == '{' {
+		closer = '}'
+		mapping = make(map[string]interface{})
+	} else {
+		sequence = []interface{}{}
+	}
+
+	text = strings.TrimLeft(text[1:], " ")
+
+	for !strings.HasPrefix(text, string(closer)) {
+
+		value, text, err = parseYAMLFlow(text)
+		if err != nil {
+			return nil, "", err
+		}
+
+		text = strings.TrimLeft(text, " ")
+
+		if mapping != nil {
+
+			if !strings.HasPrefix(text, ":") {
+				return nil, "", errors.New("Expected ':' after key")
+			}
+
+			key = fmt.Sprintf("%v", value)
+			value, text, err = parseYAMLFlow(text[1:])
+			if err != nil {
+				return nil, "", err
+			}
+
+			mapping[key] = value
+			text = strings.TrimLeft(text, " ")
+		} else {
+			sequence = append(sequence, value)
+		}
+
+		if strings.HasPrefix(text, ",") {
+			text = strings.TrimLeft(text[1:], " ")
+			continue
+		}
+
+		if !strings.HasPrefix(text, string(closer)) {
+			errorMsg := fmt.Sprintf("Expected ',' or '%c'", closer)
+			return nil, "", errors.New(errorMsg)
+		}
+	}
+
+	if mapping != nil {
+		return mapping, text[1:], nil
+	}
+	return sequence, text[1:], nil
+}
+
+/*
+	Returns the string inside the given quoted [text]. Double quotes have the same escapes as Go strings;
+	single quotes have none, except two single quotes for one.
+*/
+func unquoteYAML(text string) (string, error) {
+
+	if strings.HasPrefix(text, "'") {
+		return strings.Replace(text[1:len(text)-1], "''", "'", -1), nil
+	}
+	return strconv.Unquote(text)
+}
+
+/*
+	An error in a YAML document, with the line it's on.
+*/
+type yamlError struct {
+	line    int
+	message string
+}
+
+func (this *yamlError) Error() string {
+	return fmt.Sprintf("Line %d: %s", this.line, this.message)
+}
+
+func getYAMLError(line *yamlLine, message string) error {
+	return &yamlError{line: line.number, message: message}
+}